@@ -0,0 +1,472 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"sync"
+)
+
+// AudioBands is the number of frequency bands of the audio analysis.
+const AudioBands = 8
+
+// AudioLevels is the analysis of a short window of audio.
+//
+// All the values are in [0, 1], 0 being silence and 1 full scale.
+type AudioLevels struct {
+	Level float32             // Overall loudness
+	Bands [AudioBands]float32 // Loudness of each frequency band, from bass to treble
+}
+
+// band returns the overall level for 0 and the level of a frequency band in
+// [1, AudioBands].
+func (a *AudioLevels) band(b int) float32 {
+	if b <= 0 {
+		return a.Level
+	}
+	if b > AudioBands {
+		return 0
+	}
+	return a.Bands[b-1]
+}
+
+// AudioInput provides the audio levels to the audio reactive patterns.
+type AudioInput interface {
+	// Levels returns the analysis of the audio at timeMS.
+	//
+	// It is called by every audio reactive pattern and value on each frame so
+	// it should cache its result.
+	Levels(timeMS uint32) AudioLevels
+}
+
+var (
+	audioLock  sync.Mutex
+	audioInput AudioInput
+)
+
+// SetAudioInput sets the input used by VUMeter, Spectrum and AudioLevel.
+//
+// Use nil to disable; the patterns then behave as if it was silent.
+func SetAudioInput(a AudioInput) {
+	audioLock.Lock()
+	defer audioLock.Unlock()
+	audioInput = a
+}
+
+func currentAudioLevels(timeMS uint32) AudioLevels {
+	audioLock.Lock()
+	a := audioInput
+	audioLock.Unlock()
+	if a == nil {
+		return AudioLevels{}
+	}
+	return a.Levels(timeMS)
+}
+
+// PCM is a recorded mono audio clip.
+//
+// The levels at timeMS only depend on the samples so it can be used to test
+// audio reactive patterns offline or to play an animation synchronized with a
+// song. It is silent after the end of the clip.
+//
+// Samples must not be modified once Levels was called.
+type PCM struct {
+	SampleRate int
+	Samples    []int16
+	lock       sync.Mutex
+	cache      audioCache
+}
+
+func (p *PCM) Levels(timeMS uint32) AudioLevels {
+	if p.SampleRate <= 0 {
+		return AudioLevels{}
+	}
+	// Use 64 bits since timeMS*SampleRate overflows int on 32 bits platforms
+	// after a few hours.
+	end := uint64(timeMS) * uint64(p.SampleRate) / 1000
+	if end > uint64(len(p.Samples)) {
+		return AudioLevels{}
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.cache.hit(timeMS) {
+		return p.cache.levels
+	}
+	start := int(end) - audioWindow
+	if start < 0 {
+		start = 0
+	}
+	return p.cache.analyse(timeMS, p.Samples[start:end], p.SampleRate)
+}
+
+// ReadPCM reads raw signed 16 bits little endian PCM audio until EOF.
+//
+// Multiple channels are mixed down to mono.
+func ReadPCM(r io.Reader, sampleRate, channels int) (*PCM, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if sampleRate <= 0 || channels <= 0 {
+		return nil, errors.New("invalid audio format")
+	}
+	return &PCM{SampleRate: sampleRate, Samples: decodePCM(b, channels)}, nil
+}
+
+// ReadWAV reads a WAV file containing signed 16 bits PCM audio.
+//
+// Multiple channels are mixed down to mono.
+func ReadWAV(r io.Reader) (*PCM, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 12 || string(b[0:4]) != "RIFF" || string(b[8:12]) != "WAVE" {
+		return nil, errors.New("not a WAV file")
+	}
+	channels := 0
+	sampleRate := 0
+	for b = b[12:]; len(b) >= 8; {
+		id := string(b[0:4])
+		size := int(binary.LittleEndian.Uint32(b[4:8]))
+		b = b[8:]
+		if size > len(b) {
+			// Some encoders do not set the data size when streaming.
+			size = len(b)
+		}
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return nil, errors.New("invalid WAV format chunk")
+			}
+			if binary.LittleEndian.Uint16(b[0:2]) != 1 || binary.LittleEndian.Uint16(b[14:16]) != 16 {
+				return nil, errors.New("only 16 bits PCM WAV files are supported")
+			}
+			channels = int(binary.LittleEndian.Uint16(b[2:4]))
+			sampleRate = int(binary.LittleEndian.Uint32(b[4:8]))
+		case "data":
+			if channels <= 0 || sampleRate <= 0 {
+				return nil, errors.New("missing WAV format chunk")
+			}
+			return &PCM{SampleRate: sampleRate, Samples: decodePCM(b[:size], channels)}, nil
+		}
+		// Chunks are padded to an even size.
+		size += size & 1
+		if size > len(b) {
+			size = len(b)
+		}
+		b = b[size:]
+	}
+	return nil, errors.New("missing WAV data chunk")
+}
+
+// LiveAudio analyses a live stream of audio, e.g. from stdin or an ALSA
+// device.
+//
+// Write raw signed 16 bits little endian PCM audio to it, usually with
+// io.Copy() in a goroutine. The levels are the ones of the latest samples
+// written, independent of timeMS.
+type LiveAudio struct {
+	sampleRate int
+	channels   int
+	lock       sync.Mutex
+	samples    []int16 // Ring buffer of the last audioWindow samples
+	pos        int
+	partial    []byte // Incomplete sample frame from the last Write()
+	writes     uint32 // Incremented each time samples are added, to invalidate cache
+	window     []int16
+	cache      audioCache
+}
+
+// MakeLiveAudio returns a LiveAudio for a stream of the specified format.
+func MakeLiveAudio(sampleRate, channels int) *LiveAudio {
+	if channels <= 0 {
+		channels = 1
+	}
+	return &LiveAudio{sampleRate: sampleRate, channels: channels, samples: make([]int16, audioWindow)}
+}
+
+// Write implements io.Writer.
+func (l *LiveAudio) Write(b []byte) (int, error) {
+	n := len(b)
+	frame := 2 * l.channels
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if len(l.partial) != 0 {
+		b = append(l.partial, b...)
+		l.partial = nil
+	}
+	if extra := len(b) % frame; extra != 0 {
+		l.partial = append([]byte(nil), b[len(b)-extra:]...)
+		b = b[:len(b)-extra]
+	}
+	if len(b) != 0 {
+		for _, s := range decodePCM(b, l.channels) {
+			l.samples[l.pos] = s
+			l.pos = (l.pos + 1) % len(l.samples)
+		}
+		l.writes++
+	}
+	return n, nil
+}
+
+func (l *LiveAudio) Levels(timeMS uint32) AudioLevels {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.cache.hit(l.writes) {
+		return l.cache.levels
+	}
+	if l.window == nil {
+		l.window = make([]int16, len(l.samples))
+	}
+	n := copy(l.window, l.samples[l.pos:])
+	copy(l.window[n:], l.samples[:l.pos])
+	return l.cache.analyse(l.writes, l.window, l.sampleRate)
+}
+
+// VUMeter shows the audio level as a bar growing from pixel 0.
+type VUMeter struct {
+	Colors Palette // Color along the bar, defaults to green, yellow then red
+	Band   int     // Frequency band to follow in [1, AudioBands], 0 follows the overall level
+}
+
+var defaultVUMeterColors = Palette{
+	{Color{0x00, 0xFF, 0x00}, 0},
+	{Color{0xFF, 0xFF, 0x00}, 0.7},
+	{Color{0xFF, 0x00, 0x00}, 1},
+}
+
+func (v *VUMeter) NextFrame(pixels Frame, timeMS uint32) {
+	colors := v.Colors
+	if len(colors) == 0 {
+		colors = defaultVUMeterColors
+	}
+	a := currentAudioLevels(timeMS)
+	// In 24.8 fixed point.
+	n := int(a.band(v.Band)*float32(len(pixels))*256 + 0.5)
+	max := float32(len(pixels) - 1)
+	if max == 0 {
+		max = 1
+	}
+	for i := range pixels {
+		c := Color{}
+		if w := n - i<<8; w > 0 {
+			c = colors.At(float32(i) / max)
+			if w < 256 {
+				c = scaleColor(c, uint16(w))
+			}
+		}
+		pixels[i] = c
+	}
+}
+
+// Spectrum shows the level of each frequency band side by side, from the
+// bass on pixel 0 to the treble.
+type Spectrum struct {
+	Colors Palette // Color of each band, defaults to "rainbow"
+}
+
+func (s *Spectrum) NextFrame(pixels Frame, timeMS uint32) {
+	colors := s.Colors
+	if len(colors) == 0 {
+		colors = namedPalettes["rainbow"]
+	}
+	a := currentAudioLevels(timeMS)
+	for i := range pixels {
+		b := i * AudioBands / len(pixels)
+		c := colors.At(float32(b) / (AudioBands - 1))
+		pixels[i] = scaleColor(c, uint16(a.Bands[b]*256+0.5))
+	}
+}
+
+// AudioLevel is a Value that follows the audio level, for example to make a
+// Dim or a Rotate speed react to the bass.
+type AudioLevel struct {
+	Band int     // Frequency band to follow in [1, AudioBands], 0 follows the overall level
+	Min  float32 // Value when silent
+	Max  float32 // Value at full scale; when both Min and Max are 0, the value is in [0, 1]
+}
+
+func (a *AudioLevel) Eval(timeMS uint32) float32 {
+	l := currentAudioLevels(timeMS)
+	max := a.Max
+	if a.Min == 0 && max == 0 {
+		max = 1
+	}
+	return a.Min + (max-a.Min)*l.band(a.Band)
+}
+
+// Private stuff.
+
+// audioWindow is the number of samples analysed. It must be a power of 2.
+const audioWindow = 1024
+
+const (
+	audioMinDB   = -60   // Level mapped to 0
+	audioMinFreq = 40    // Lowest frequency of the first band
+	audioMaxFreq = 16000 // Highest frequency of the last band
+)
+
+// hann is the Hann window to reduce spectral leakage.
+var hann = func() []float32 {
+	w := make([]float32, audioWindow)
+	for i := range w {
+		w[i] = 0.5 - 0.5*cos(2*math.Pi*float32(i)/float32(audioWindow-1))
+	}
+	return w
+}()
+
+// decodePCM decodes signed 16 bits little endian samples and mixes the
+// channels down to mono.
+func decodePCM(b []byte, channels int) []int16 {
+	frame := 2 * channels
+	out := make([]int16, len(b)/frame)
+	for i := range out {
+		sum := 0
+		for c := 0; c < channels; c++ {
+			sum += int(int16(binary.LittleEndian.Uint16(b[i*frame+2*c:])))
+		}
+		out[i] = int16(sum / channels)
+	}
+	return out
+}
+
+// audioCache keeps the last analysis so the same window of audio is analysed
+// only once even when multiple patterns and values react to it, and reuses the
+// FFT buffers.
+type audioCache struct {
+	valid  bool
+	key    uint32 // timeMS for PCM, number of writes for LiveAudio
+	levels AudioLevels
+	re, im []float32
+}
+
+// hit returns true if the levels were already calculated for key.
+func (c *audioCache) hit(key uint32) bool {
+	return c.valid && c.key == key
+}
+
+// analyse calculates and caches the levels for key.
+func (c *audioCache) analyse(key uint32, samples []int16, sampleRate int) AudioLevels {
+	if c.re == nil {
+		c.re = make([]float32, audioWindow)
+		c.im = make([]float32, audioWindow)
+	}
+	c.levels = analyseAudio(samples, sampleRate, c.re, c.im)
+	c.key = key
+	c.valid = true
+	return c.levels
+}
+
+// analyseAudio returns the levels of the last audioWindow samples.
+//
+// re and im are scratch buffers of audioWindow items.
+func analyseAudio(samples []int16, sampleRate int, re, im []float32) AudioLevels {
+	var a AudioLevels
+	if sampleRate <= 0 {
+		return a
+	}
+	if len(samples) > audioWindow {
+		samples = samples[len(samples)-audioWindow:]
+	}
+	for i := range re {
+		re[i] = 0
+		im[i] = 0
+	}
+	// Right align the samples so a short clip is zero padded at the start.
+	offset := audioWindow - len(samples)
+	var sum float32
+	for i, s := range samples {
+		x := float32(s) / 32768
+		sum += x * x
+		re[offset+i] = x * hann[offset+i]
+	}
+	a.Level = dbToLevel(sqrt(sum / audioWindow))
+	fft(re, im)
+	// Logarithmic bands so each covers a similar musical range.
+	maxFreq := float32(audioMaxFreq)
+	if nyquist := float32(sampleRate) / 2; maxFreq > nyquist {
+		maxFreq = nyquist
+	}
+	binHz := float32(sampleRate) / audioWindow
+	lo := int(audioMinFreq/binHz + 0.5)
+	if lo < 1 {
+		lo = 1
+	}
+	for b := range a.Bands {
+		hi := int(audioMinFreq*pow(maxFreq/audioMinFreq, float32(b+1)/AudioBands)/binHz + 0.5)
+		if hi <= lo {
+			hi = lo + 1
+		}
+		if hi > audioWindow/2 {
+			hi = audioWindow / 2
+		}
+		var e float32
+		for k := lo; k < hi; k++ {
+			e += re[k]*re[k] + im[k]*im[k]
+		}
+		// Scale so a sine wave has the same level in its band as its overall
+		// level; the Hann window keeps 3/8 of the energy.
+		a.Bands[b] = dbToLevel(sqrt(e*16/3) / audioWindow)
+		lo = hi
+	}
+	return a
+}
+
+// dbToLevel maps a RMS amplitude in [0, 1] to a level in [0, 1] on a
+// logarithmic scale.
+func dbToLevel(rms float32) float32 {
+	if rms <= 0 {
+		return 0
+	}
+	db := 20 * logn(rms) / math.Ln10
+	l := (db - audioMinDB) / -audioMinDB
+	if l < 0 {
+		return 0
+	}
+	if l > 1 {
+		return 1
+	}
+	return l
+}
+
+// fft calculates in place the discrete Fourier transform of the complex
+// values re and im. The length must be a power of 2.
+func fft(re, im []float32) {
+	n := len(re)
+	// Bit reversal permutation.
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			re[i], re[j] = re[j], re[i]
+			im[i], im[j] = im[j], im[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		half := size >> 1
+		step := -2 * math.Pi / float32(size)
+		for k := 0; k < half; k++ {
+			wr := cos(step * float32(k))
+			wi := sin(step * float32(k))
+			for i := k; i < n; i += size {
+				j := i + half
+				tr := wr*re[j] - wi*im[j]
+				ti := wr*im[j] + wi*re[j]
+				re[j] = re[i] - tr
+				im[j] = im[i] - ti
+				re[i] += tr
+				im[i] += ti
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/maruel/ut"
+)
+
+func TestReadWAV(t *testing.T) {
+	p, err := ReadWAV(bytes.NewReader(makeWAV(8000, 2, sineWave(8000, 100, 440, 1))))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 8000, p.SampleRate)
+	ut.AssertEqual(t, 800, len(p.Samples))
+	ut.AssertEqual(t, sineWave(8000, 100, 440, 1), p.Samples)
+	for _, b := range [][]byte{nil, []byte("RIFF0000WAVE"), makeWAV(8000, 0, nil)} {
+		if _, err := ReadWAV(bytes.NewReader(b)); err == nil {
+			t.Fatalf("%q should fail", b)
+		}
+	}
+}
+
+func TestReadPCM(t *testing.T) {
+	p, err := ReadPCM(bytes.NewReader([]byte{0x00, 0x01, 0x00, 0x03, 0xFF, 0xFF, 0x01, 0x00}), 100, 2)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, &PCM{SampleRate: 100, Samples: []int16{0x200, 0}}, p)
+}
+
+func TestAudioLevels(t *testing.T) {
+	const rate = 44100
+	silence := &PCM{SampleRate: rate, Samples: make([]int16, rate)}
+	ut.AssertEqual(t, AudioLevels{}, silence.Levels(500))
+
+	bass := &PCM{SampleRate: rate, Samples: sineWave(rate, 1000, 100, 1)}
+	a := bass.Levels(500)
+	// A full scale sine wave is at -3dB.
+	ut.AssertEqual(t, true, a.Level > 0.94 && a.Level < 0.96)
+	ut.AssertEqual(t, true, a.Bands[1] > 0.9)
+	ut.AssertEqual(t, true, a.Bands[5] < 0.3)
+	// Quieter.
+	q := (&PCM{SampleRate: rate, Samples: sineWave(rate, 1000, 100, 0.1)}).Levels(500)
+	ut.AssertEqual(t, true, abs(a.Level-q.Level-20./60) < 0.01)
+
+	treble := (&PCM{SampleRate: rate, Samples: sineWave(rate, 1000, 5000, 1)}).Levels(500)
+	ut.AssertEqual(t, true, treble.Bands[6] > 0.9)
+	ut.AssertEqual(t, true, treble.Bands[1] < 0.3)
+
+	// After the end of the clip.
+	ut.AssertEqual(t, AudioLevels{}, bass.Levels(2000))
+	// Long after the end; timeMS*SampleRate doesn't fit in 32 bits.
+	for _, timeMS := range []uint32{48700000, math.MaxUint32 - 1, math.MaxUint32} {
+		ut.AssertEqual(t, AudioLevels{}, bass.Levels(timeMS))
+	}
+}
+
+func TestLiveAudio(t *testing.T) {
+	l := MakeLiveAudio(8000, 1)
+	ut.AssertEqual(t, AudioLevels{}, l.Levels(0))
+	b := makeWAV(8000, 1, sineWave(8000, 1000, 440, 1))[44:]
+	// Split in odd chunks.
+	for i := 0; i < len(b); i += 333 {
+		end := i + 333
+		if end > len(b) {
+			end = len(b)
+		}
+		n, err := l.Write(b[i:end])
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, end-i, n)
+	}
+	a := l.Levels(0)
+	ut.AssertEqual(t, true, a.Level > 0.94 && a.Level < 0.96)
+	ut.AssertEqual(t, a, l.Levels(1))
+
+	// Writing invalidates the cached levels.
+	_, err := l.Write(make([]byte, 2*audioWindow))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, AudioLevels{}, l.Levels(1))
+}
+
+func TestAudioCache(t *testing.T) {
+	const rate = 44100
+	p := &PCM{SampleRate: rate, Samples: sineWave(rate, 1000, 100, 1)}
+	a := p.Levels(500)
+	ut.AssertEqual(t, true, p.cache.hit(500))
+	ut.AssertEqual(t, a, p.Levels(500))
+	// The scratch buffers are reused.
+	timeMS := uint32(0)
+	allocs := testing.AllocsPerRun(10, func() {
+		timeMS++
+		p.Levels(timeMS)
+	})
+	ut.AssertEqual(t, 0., allocs)
+}
+
+func TestVUMeter(t *testing.T) {
+	defer SetAudioInput(nil)
+	green := Color{0x00, 0xFF, 0x00}
+	testFrame(t, &VUMeter{}, expectation{0, Frame{{}, {}, {}, {}}})
+	SetAudioInput(&PCM{SampleRate: 1000, Samples: make([]int16, 1000)})
+	testFrame(t, &VUMeter{}, expectation{500, Frame{{}, {}, {}, {}}})
+	SetAudioInput(&fakeAudio{AudioLevels{Level: 0.5, Bands: [AudioBands]float32{1}}})
+	g := Palette{{green, 0}}
+	testFrame(t, &VUMeter{Colors: g}, expectation{0, Frame{green, green, {}, {}}})
+	testFrame(t, &VUMeter{Colors: g}, expectation{0, Frame{green, green, {0x00, 0x80, 0x00}, {}, {}}})
+	testFrame(t, &VUMeter{Band: 2}, expectation{0, Frame{{}, {}}})
+	// Default colors.
+	testFrame(t, &VUMeter{Band: 1}, expectation{0, Frame{green, {0xB6, 0xFF, 0x00}, {0xFF, 0x00, 0x00}}})
+}
+
+func TestSpectrum(t *testing.T) {
+	defer SetAudioInput(nil)
+	testFrame(t, &Spectrum{}, expectation{0, Frame{{}, {}, {}, {}, {}, {}, {}, {}}})
+	red := Color{0xFF, 0x00, 0x00}
+	p := &Spectrum{Colors: Palette{{red, 0}}}
+	SetAudioInput(&fakeAudio{AudioLevels{Bands: [AudioBands]float32{1, 0.5, 0, 0, 0, 0, 0, 1}}})
+	testFrame(t, p, expectation{0, Frame{red, {0x80, 0x00, 0x00}, {}, {}, {}, {}, {}, red}})
+	testFrame(t, p, expectation{0, Frame{red, red, {0x80, 0x00, 0x00}, {0x80, 0x00, 0x00}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, red, red}})
+}
+
+func TestAudioLevel(t *testing.T) {
+	defer SetAudioInput(nil)
+	v := &AudioLevel{}
+	ut.AssertEqual(t, float32(0), v.Eval(0))
+	SetAudioInput(&fakeAudio{AudioLevels{Level: 0.5, Bands: [AudioBands]float32{0.25}}})
+	ut.AssertEqual(t, float32(0.5), v.Eval(0))
+	v = &AudioLevel{Band: 1, Min: 10, Max: 20}
+	ut.AssertEqual(t, float32(12.5), v.Eval(0))
+	// Animates a parameter.
+	d := &Dim{Child: SPattern{&Color{0xFF, 0xFF, 0xFF}}, Intensity: SValue{&AudioLevel{}}}
+	testFrame(t, d, expectation{0, Frame{{0x80, 0x80, 0x80}}})
+}
+
+//
+
+type fakeAudio struct {
+	a AudioLevels
+}
+
+func (f *fakeAudio) Levels(timeMS uint32) AudioLevels {
+	return f.a
+}
+
+// sineWave returns a sine wave of durationMS at freq Hz.
+func sineWave(sampleRate, durationMS int, freq, amplitude float64) []int16 {
+	out := make([]int16, sampleRate*durationMS/1000)
+	for i := range out {
+		out[i] = int16(32767 * amplitude * math.Sin(2*math.Pi*freq*float64(i)/float64(sampleRate)))
+	}
+	return out
+}
+
+// makeWAV returns a 16 bits PCM WAV file with the samples duplicated on each
+// channel.
+func makeWAV(sampleRate, channels int, samples []int16) []byte {
+	var b bytes.Buffer
+	size := 2 * channels * len(samples)
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(36+size))
+	b.WriteString("WAVEfmt ")
+	for _, v := range []interface{}{uint32(16), uint16(1), uint16(channels), uint32(sampleRate), uint32(2 * channels * sampleRate), uint16(2 * channels), uint16(16)} {
+		binary.Write(&b, binary.LittleEndian, v)
+	}
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, uint32(size))
+	for _, s := range samples {
+		for c := 0; c < channels; c++ {
+			binary.Write(&b, binary.LittleEndian, s)
+		}
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,135 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WallClock provides the wall time to Clock.
+type WallClock interface {
+	// Now returns the current time, in the time zone to display.
+	Now() time.Time
+}
+
+var (
+	wallClockLock sync.Mutex
+	wallClock     WallClock
+)
+
+// SetWallClock sets the time source used by Clock.
+//
+// Use nil to restore the system clock in local time.
+func SetWallClock(c WallClock) {
+	wallClockLock.Lock()
+	defer wallClockLock.Unlock()
+	wallClock = c
+}
+
+func currentWallTime() time.Time {
+	wallClockLock.Lock()
+	c := wallClock
+	wallClockLock.Unlock()
+	if c == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
+
+// ClockLayout specifies how Clock draws the time.
+type ClockLayout string
+
+const (
+	ClockDots ClockLayout = "dots" // One pixel per hand like on a watch dial, default value.
+	ClockArcs ClockLayout = "arcs" // The strip is split in one part per hand, each filled proportionally.
+)
+
+func (c ClockLayout) validate() error {
+	switch c {
+	case "", ClockDots, ClockArcs:
+		return nil
+	}
+	return fmt.Errorf("unknown clock layout %q", string(c))
+}
+
+// Clock shows the wall time as set with SetWallClock.
+//
+// With ClockDots, pixel 0 is 12 o'clock and the hands overlapping add up. Use
+// Rotate or Reverse to orient the dial. Unlike the other patterns it doesn't
+// depend on timeMS so all devices show the same time only if their clocks are
+// synchronized.
+type Clock struct {
+	Layout      ClockLayout // Defaults to ClockDots
+	Hour        Color       // Defaults to red
+	Minute      Color       // Defaults to green
+	Second      Color       // Defaults to blue
+	Background  Color
+	HideSeconds bool
+	Hours24     bool // The hour hand does one turn per day instead of two
+}
+
+func (c *Clock) NextFrame(pixels Frame, timeMS uint32) {
+	for i := range pixels {
+		pixels[i] = c.Background
+	}
+	if len(pixels) == 0 {
+		return
+	}
+	now := currentWallTime()
+	s := float32(now.Second()) / 60
+	m := (float32(now.Minute()) + s) / 60
+	h := (float32(now.Hour()%12) + m) / 12
+	if c.Hours24 {
+		h = (float32(now.Hour()) + m) / 24
+	}
+	hands := []struct {
+		f float32
+		c Color
+	}{
+		{h, orDefault(c.Hour, Color{0xFF, 0x00, 0x00})},
+		{m, orDefault(c.Minute, Color{0x00, 0xFF, 0x00})},
+		{s, orDefault(c.Second, Color{0x00, 0x00, 0xFF})},
+	}
+	if c.HideSeconds {
+		hands = hands[:2]
+	}
+	if c.Layout == ClockArcs {
+		for k, hand := range hands {
+			part := pixels[k*len(pixels)/len(hands) : (k+1)*len(pixels)/len(hands)]
+			// In 24.8 fixed point.
+			n := int(hand.f*float32(len(part))*256 + 0.5)
+			for i := range part {
+				if w := n - i<<8; w >= 256 {
+					part[i] = hand.c
+				} else if w > 0 {
+					part[i].Mix(hand.c, uint8(w))
+				}
+			}
+		}
+		return
+	}
+	for k, hand := range hands {
+		i := int(hand.f * float32(len(pixels)))
+		overlap := false
+		for _, prev := range hands[:k] {
+			overlap = overlap || int(prev.f*float32(len(pixels))) == i
+		}
+		if overlap {
+			pixels[i].Add(hand.c)
+		} else {
+			pixels[i] = hand.c
+		}
+	}
+}
+
+// orDefault returns d if c is black.
+func orDefault(c, d Color) Color {
+	if c == (Color{}) {
+		return d
+	}
+	return c
+}
@@ -0,0 +1,34 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	defer SetWallClock(nil)
+	r := Color{0xFF, 0x00, 0x00}
+	g := Color{0x00, 0xFF, 0x00}
+	b := Color{0x00, 0x00, 0xFF}
+	k := Color{0x00, 0x00, 0x10}
+	SetWallClock(fakeClock(time.Date(2016, 10, 1, 15, 30, 45, 0, time.UTC)))
+	testFrame(t, &Clock{Background: k}, expectation{0, Frame{k, k, k, r, k, k, g, k, k, b, k, k}})
+	testFrame(t, &Clock{HideSeconds: true}, expectation{0, Frame{{}, {}, {}, r, {}, {}, g, {}, {}, {}, {}, {}}})
+	testFrame(t, &Clock{Hours24: true, Hour: b, Second: r}, expectation{0, Frame{{}, {}, {}, {}, {}, {}, g, b, {}, r, {}, {}}})
+	testFrame(t, &Clock{Layout: ClockArcs, HideSeconds: true}, expectation{0, Frame{{0xE1, 0x00, 0x00}, {}, {}, g, {0x00, 0x8A, 0x00}, {}}})
+	testFrame(t, &Clock{Layout: ClockArcs}, expectation{0, Frame{r, r, {0x57, 0x00, 0x00}, {}, {}, {}, {}, {}, g, g, g, g, {0x00, 0x1A, 0x00}, {}, {}, {}, b, b, b, b, b, b, {}, {}}})
+	// Overlapping hands add up.
+	SetWallClock(fakeClock(time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC)))
+	w := Color{0xFF, 0xFF, 0xFF}
+	testFrame(t, &Clock{}, expectation{0, Frame{w, {}, {}, {}}})
+}
+
+type fakeClock time.Time
+
+func (f fakeClock) Now() time.Time {
+	return time.Time(f)
+}
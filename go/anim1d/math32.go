@@ -14,6 +14,10 @@ func ceil(x float32) float32 {
 	return float32(math.Ceil(float64(x)))
 }
 
+func cos(x float32) float32 {
+	return float32(math.Cos(float64(x)))
+}
+
 func FloatToUint8(x float32) uint8 {
 	if x >= 254.4 {
 		return 255
@@ -24,6 +28,10 @@ func FloatToUint8(x float32) uint8 {
 	return uint8(roundF(x))
 }
 
+func floor(x float32) float32 {
+	return float32(math.Floor(float64(x)))
+}
+
 func hypot(x, y float32) float32 {
 	return float32(math.Hypot(float64(x), float64(y)))
 }
@@ -40,9 +48,26 @@ func sin(x float32) float32 {
 	return float32(math.Sin(float64(x)))
 }
 
+func sqrt(x float32) float32 {
+	return float32(math.Sqrt(float64(x)))
+}
+
+// mod returns x modulo y, always positive.
+func mod(x, y float32) float32 {
+	return x - floor(x/y)*y
+}
+
+func pow(x, y float32) float32 {
+	return float32(math.Pow(float64(x), float64(y)))
+}
+
 func roundF(x float32) float32 {
 	if x < 0 {
 		return ceil(x - 0.5)
 	}
 	return ceil(x + 0.5)
 }
+
+func exp(x float32) float32 {
+	return float32(math.Exp(float64(x)))
+}
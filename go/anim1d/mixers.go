@@ -4,6 +4,15 @@
 
 package anim1d
 
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
 // TransitionType models visually pleasing transitions.
 //
 // They are modeled against CSS transitions.
@@ -21,44 +30,229 @@ const (
 	TransitionStepEnd    TransitionType = "steps(1,end)"
 )
 
+// TransitionCubicBezier returns a "cubic-bezier(x0,y0,x1,y1)" transition.
+//
+// x0 and x1 must be in [0, 1].
+func TransitionCubicBezier(x0, y0, x1, y1 float32) TransitionType {
+	return TransitionType(fmt.Sprintf("cubic-bezier(%g,%g,%g,%g)", x0, y0, x1, y1))
+}
+
+// TransitionSteps returns a "steps(N,pos)" transition.
+//
+// pos must be one of "start", "middle" or "end".
+func TransitionSteps(n int, pos string) TransitionType {
+	return TransitionType(fmt.Sprintf("steps(%d,%s)", n, pos))
+}
+
 const epsilon = 1e-7
 
 // scale scales input [0, 1] to output [0, 1] using the transition requested.
 //
+// Invalid values are treated as the default value; they are rejected when
+// unmarshalling. Use resolve() when scaling many values in a loop.
+//
 // TODO(maruel): Implement a version that is integer based.
 func (t TransitionType) scale(intensity float32) float32 {
-	// TODO(maruel): Add support for arbitrary cubic-bezier().
-	// TODO(maruel): Map ease-* to cubic-bezier().
-	// TODO(maruel): Add support for steps() which is pretty cool.
-	switch t {
-	case TransitionEase:
-		return cubicBezier(0.25, 0.1, 0.25, 1, intensity)
-	case TransitionEaseIn:
-		return cubicBezier(0.42, 0, 1, 1, intensity)
-	case TransitionEaseInOut:
-		return cubicBezier(0.42, 0, 0.58, 1, intensity)
-	case TransitionEaseOut, "":
-		fallthrough
-	default:
-		return cubicBezier(0, 0, 0.58, 1, intensity)
-	case TransitionLinear:
+	c := t.resolve()
+	return c.scale(intensity)
+}
+
+// validate returns an error if the transition is unknown or is a malformed
+// cubic-bezier() or steps().
+func (t TransitionType) validate() error {
+	if _, ok := builtinTransitions[t]; ok {
+		return nil
+	}
+	_, err := t.curve()
+	return err
+}
+
+// resolve returns the parsed transition, or the default value if invalid.
+func (t TransitionType) resolve() transitionCurve {
+	if c, ok := builtinTransitions[t]; ok {
+		return c
+	}
+	if c, err := t.curve(); err == nil {
+		return c
+	}
+	return builtinTransitions[TransitionEaseOut]
+}
+
+// transitionCurve is a parsed transition.
+type transitionCurve struct {
+	x0, y0, x1, y1 float32 // Set for cubic-bezier()
+	steps          int     // Set for steps()
+	pos            stepPosition
+	linear         bool
+}
+
+func (c *transitionCurve) scale(intensity float32) float32 {
+	if c.linear {
 		return intensity
-	case TransitionStepStart:
-		if intensity < 0.+epsilon {
-			return 0
+	}
+	if c.steps != 0 {
+		return steps(c.steps, c.pos, intensity)
+	}
+	return cubicBezier(c.x0, c.y0, c.x1, c.y1, intensity)
+}
+
+// builtinTransitions are the named transitions. It is read only.
+var builtinTransitions = map[TransitionType]transitionCurve{
+	"":                   {x0: 0, y0: 0, x1: 0.58, y1: 1},
+	TransitionEase:       {x0: 0.25, y0: 0.1, x1: 0.25, y1: 1},
+	TransitionEaseIn:     {x0: 0.42, y0: 0, x1: 1, y1: 1},
+	TransitionEaseInOut:  {x0: 0.42, y0: 0, x1: 0.58, y1: 1},
+	TransitionEaseOut:    {x0: 0, y0: 0, x1: 0.58, y1: 1},
+	TransitionLinear:     {linear: true},
+	TransitionStepStart:  {steps: 1, pos: stepStart},
+	TransitionStepMiddle: {steps: 1, pos: stepMiddle},
+	TransitionStepEnd:    {steps: 1, pos: stepEnd},
+}
+
+// maxTransitions bounds the number of parsed cubic-bezier() and steps()
+// transitions kept in cache, since the strings can come from remote requests.
+const maxTransitions = 256
+
+var (
+	transitionsLock sync.RWMutex
+	transitions     = map[TransitionType]transitionCurve{}
+)
+
+// curve returns the parsed cubic-bezier() or steps() transition.
+//
+// Valid results are cached so the string is parsed only once, usually when
+// unmarshalling, instead of at every frame.
+func (t TransitionType) curve() (transitionCurve, error) {
+	transitionsLock.RLock()
+	c, ok := transitions[t]
+	transitionsLock.RUnlock()
+	if ok {
+		return c, nil
+	}
+	var err error
+	switch {
+	case strings.HasPrefix(string(t), cubicBezierKey+"("):
+		c.x0, c.y0, c.x1, c.y1, err = t.parseCubicBezier()
+	case strings.HasPrefix(string(t), stepsKey+"("):
+		c.steps, c.pos, err = t.parseSteps()
+	default:
+		err = fmt.Errorf("unknown transition %q", string(t))
+	}
+	if err != nil {
+		return transitionCurve{}, err
+	}
+	transitionsLock.Lock()
+	if len(transitions) < maxTransitions {
+		transitions[t] = c
+	}
+	transitionsLock.Unlock()
+	return c, nil
+}
+
+const (
+	cubicBezierKey = "cubic-bezier"
+	stepsKey       = "steps"
+)
+
+// parseCubicBezier parses "cubic-bezier(x0,y0,x1,y1)".
+func (t TransitionType) parseCubicBezier() (x0, y0, x1, y1 float32, err error) {
+	args, err := parseFunc(string(t), cubicBezierKey)
+	if err != nil {
+		return
+	}
+	if len(args) != 4 {
+		err = errors.New("cubic-bezier() requires 4 arguments")
+		return
+	}
+	var v [4]float32
+	for i, a := range args {
+		f, err2 := strconv.ParseFloat(a, 32)
+		if err2 != nil {
+			err = err2
+			return
 		}
-		return 1
-	case TransitionStepMiddle:
-		if intensity < 0.5 {
-			return 0
+		v[i] = float32(f)
+	}
+	if v[0] < 0 || v[0] > 1 || v[2] < 0 || v[2] > 1 {
+		err = errors.New("cubic-bezier() x values must be in [0, 1]")
+		return
+	}
+	return v[0], v[1], v[2], v[3], nil
+}
+
+type stepPosition int
+
+const (
+	stepStart stepPosition = iota
+	stepMiddle
+	stepEnd
+)
+
+// parseSteps parses "steps(N)" or "steps(N,start|middle|end)".
+func (t TransitionType) parseSteps() (int, stepPosition, error) {
+	args, err := parseFunc(string(t), stepsKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(args) != 1 && len(args) != 2 {
+		return 0, 0, errors.New("steps() requires 1 or 2 arguments")
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	if n < 1 {
+		return 0, 0, errors.New("steps() requires a positive number of steps")
+	}
+	// Like CSS, the default is "end".
+	pos := stepEnd
+	if len(args) == 2 {
+		switch args[1] {
+		case "start":
+			pos = stepStart
+		case "middle":
+			pos = stepMiddle
+		case "end":
+		default:
+			return 0, 0, errors.New("steps() position must be one of start, middle or end")
 		}
+	}
+	return n, pos, nil
+}
+
+// parseFunc parses "name(arg1,arg2,...)" and returns the trimmed arguments.
+//
+// Do the parsing manually instead of using a regexp so the code is more
+// portable to C on an ESP8266.
+func parseFunc(s, name string) ([]string, error) {
+	if !strings.HasPrefix(s, name+"(") || !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("expected %s()", name)
+	}
+	args := strings.Split(s[len(name)+1:len(s)-1], ",")
+	for i := range args {
+		args[i] = strings.TrimSpace(args[i])
+	}
+	return args, nil
+}
+
+// steps returns a step function of n steps for input [0, 1].
+func steps(n int, pos stepPosition, intensity float32) float32 {
+	if intensity > 1.-epsilon {
 		return 1
-	case TransitionStepEnd:
-		if intensity > 1.-epsilon {
-			return 1
-		}
+	}
+	if intensity < 0.+epsilon {
 		return 0
 	}
+	f := intensity * float32(n)
+	switch pos {
+	case stepStart:
+		f = ceil(f)
+	case stepMiddle:
+		f = floor(f + 0.5)
+	default:
+		f = floor(f)
+	}
+	return f / float32(n)
 }
 
 // ScalingType specifies a way to scales a pixel strip.
@@ -68,7 +262,7 @@ const (
 	ScalingNearestSkip ScalingType = "nearestskip" // Selects the nearest pixel but when upscaling, skips on missing pixels.
 	ScalingNearest     ScalingType = "nearest"     // Selects the nearest pixel, gives a blocky view.
 	ScalingLinear      ScalingType = "linear"      // Linear interpolation, recommended and default value.
-	ScalingBilinear    ScalingType = "bilinear"    // Bilinear interpolation, usually overkill for 1D.
+	ScalingBilinear    ScalingType = "bilinear"    // Bilinear interpolation, usually overkill for 1D; it is the same as linear.
 )
 
 func (s ScalingType) scale(in, out Frame) {
@@ -88,21 +282,91 @@ func (s ScalingType) scale(in, out Frame) {
 			return
 		}
 		fallthrough
-	case ScalingNearest, ScalingLinear, ScalingBilinear, "":
-		fallthrough
-	default:
+	case ScalingNearest:
 		for i := range out {
 			out[i] = in[(i*li+li/2)/lo]
 		}
-		/*
-			case ScalingLinear:
-				for i := range out {
-					x := (i*li + li/2) / lo
-					c := in[x]
-					c.Add(in[x+1])
-					out[i] = c
-				}
-		*/
+	case ScalingLinear, ScalingBilinear, "":
+		fallthrough
+	default:
+		switch {
+		case li == lo:
+			copy(out, in)
+		case li < lo:
+			scaleUpLinear(in, out)
+		default:
+			scaleDownArea(in, out)
+		}
+	}
+}
+
+// scaleUpLinear interpolates each output pixel between its two nearest input
+// pixels.
+//
+// Pixel centers are aligned so the first and last pixels are the same on both
+// sides. The position is calculated in 24.8 fixed point.
+func scaleUpLinear(in, out Frame) {
+	li := len(in)
+	lo := len(out)
+	max := (li - 1) << 8
+	for i := range out {
+		// Center of the output pixel, expressed in input pixel space.
+		pos := ((2*i+1)*li<<8+lo)/(2*lo) - 128
+		if pos < 0 {
+			pos = 0
+		} else if pos > max {
+			pos = max
+		}
+		x := pos >> 8
+		if x == li-1 {
+			out[i] = in[x]
+			continue
+		}
+		out[i] = lerp(in[x], in[x+1], uint32(pos&0xFF))
+	}
+}
+
+// scaleDownArea averages the input pixels covered by each output pixel,
+// weighted by the covered area.
+//
+// An input pixel spans lo units and an output pixel spans li units so all the
+// calculations are done in integer.
+func scaleDownArea(in, out Frame) {
+	li := len(in)
+	lo := len(out)
+	for i := range out {
+		start := i * li
+		end := start + li
+		var r, g, b uint32
+		for j := start / lo; j*lo < end; j++ {
+			// Overlap of [j*lo, (j+1)*lo[ with [start, end[.
+			s := j * lo
+			if s < start {
+				s = start
+			}
+			e := (j + 1) * lo
+			if e > end {
+				e = end
+			}
+			w := uint32(e - s)
+			c := in[j]
+			r += uint32(c.R) * w
+			g += uint32(c.G) * w
+			b += uint32(c.B) * w
+		}
+		d := uint32(li)
+		out[i] = Color{uint8((r + d/2) / d), uint8((g + d/2) / d), uint8((b + d/2) / d)}
+	}
+}
+
+// lerp returns the linear interpolation between a and b, where f is in
+// [0, 256]; 0 means pure 'a', 256 means pure 'b'.
+func lerp(a, b Color, f uint32) Color {
+	f1 := 256 - f
+	return Color{
+		uint8((uint32(a.R)*f1 + uint32(b.R)*f + 128) >> 8),
+		uint8((uint32(a.G)*f1 + uint32(b.G)*f + 128) >> 8),
+		uint8((uint32(a.B)*f1 + uint32(b.B)*f + 128) >> 8),
 	}
 }
 
@@ -110,7 +374,7 @@ func (s ScalingType) scale(in, out Frame) {
 //
 // A good example is using two colors but it can also be animations.
 //
-// TODO(maruel): Support N colors at M positions.
+// Use MultiGradient for more than 2 patterns.
 type Gradient struct {
 	Left       SPattern
 	Right      SPattern
@@ -126,16 +390,138 @@ func (g *Gradient) NextFrame(pixels Frame, timeMS uint32) {
 	g.buf.reset(len(pixels))
 	g.Left.NextFrame(pixels, timeMS)
 	g.Right.NextFrame(g.buf, timeMS)
+	c := g.Transition.resolve()
 	if l == 0 {
-		pixels.Mix(g.buf, FloatToUint8(255.*g.Transition.scale(0.5)))
+		pixels.Mix(g.buf, FloatToUint8(255.*c.scale(0.5)))
 	} else {
 		// TODO(maruel): Convert to integer calculation.
 		max := float32(len(pixels) - 1)
 		for i := range pixels {
 			// [0, 1]
 			intensity := float32(i) / max
-			pixels[i].Mix(g.buf[i], FloatToUint8(255.*g.Transition.scale(intensity)))
+			pixels[i].Mix(g.buf[i], FloatToUint8(255.*c.scale(intensity)))
+		}
+	}
+}
+
+// GradientStop is one stop of a MultiGradient.
+type GradientStop struct {
+	Pattern    SPattern
+	Position   float32        // Position of the stop on the strip, in [0, 1]
+	Transition TransitionType // Type of transition to the next stop, defaults to EaseOut if not set
+}
+
+// MultiGradient does a gradient between N patterns at M positions.
+//
+// Stops must be sorted by Position. Pixels before the first stop or after the
+// last stop show the pattern of the respective stop. Two stops at the same
+// position create a hard edge.
+type MultiGradient struct {
+	Stops  []GradientStop
+	bufs   []Frame
+	curves []transitionCurve
+}
+
+func (m *MultiGradient) NextFrame(pixels Frame, timeMS uint32) {
+	if len(m.Stops) == 0 || len(pixels) == 0 {
+		return
+	}
+	if len(m.bufs) != len(m.Stops) {
+		m.bufs = make([]Frame, len(m.Stops))
+		m.curves = make([]transitionCurve, len(m.Stops))
+	}
+	for i := range m.Stops {
+		m.curves[i] = m.Stops[i].Transition.resolve()
+		m.bufs[i].reset(len(pixels))
+		if m.Stops[i].Pattern.Pattern != nil {
+			m.Stops[i].Pattern.NextFrame(m.bufs[i], timeMS)
+		}
+	}
+	last := len(m.Stops) - 1
+	max := float32(len(pixels) - 1)
+	k := 0
+	for i := range pixels {
+		// [0, 1]
+		pos := float32(0.5)
+		if max != 0 {
+			pos = float32(i) / max
+		}
+		for k < last && m.Stops[k+1].Position <= pos {
+			k++
 		}
+		if k == last || pos <= m.Stops[k].Position {
+			pixels[i] = m.bufs[k][i]
+			continue
+		}
+		s := m.Stops[k]
+		intensity := (pos - s.Position) / (m.Stops[k+1].Position - s.Position)
+		pixels[i] = m.bufs[k][i]
+		pixels[i].Mix(m.bufs[k+1][i], FloatToUint8(255.*m.curves[k].scale(intensity)))
+	}
+}
+
+// TransitionStyle specifies how the old and the new patterns are laid out on
+// the strip during a transition.
+type TransitionStyle string
+
+const (
+	StyleFade       TransitionStyle = "fade"       // Cross-fades the whole strip, default value.
+	StyleWipeLeft   TransitionStyle = "wipeleft"   // The new pattern is uncovered from the right end toward the left.
+	StyleWipeRight  TransitionStyle = "wiperight"  // The new pattern is uncovered from the left end toward the right.
+	StyleWipeCenter TransitionStyle = "wipecenter" // The new pattern is uncovered from the center toward both ends.
+	StyleSlideLeft  TransitionStyle = "slideleft"  // The new pattern enters from the right and pushes the old one to the left.
+	StyleSlideRight TransitionStyle = "slideright" // The new pattern enters from the left and pushes the old one to the right.
+	StyleDissolve   TransitionStyle = "dissolve"   // Pixels switch one by one in a pseudo random order.
+)
+
+func (s TransitionStyle) validate() error {
+	switch s {
+	case "", StyleFade, StyleWipeLeft, StyleWipeRight, StyleWipeCenter, StyleSlideLeft, StyleSlideRight, StyleDissolve:
+		return nil
+	}
+	return fmt.Errorf("unknown transition style %q", string(s))
+}
+
+// blend combines the new pattern already rendered in pixels with the old
+// pattern in before. progress is in [0, 1]; 0 means pure 'before' and 1 means
+// pure 'pixels'.
+func (s TransitionStyle) blend(pixels, before Frame, progress float32) {
+	l := len(pixels)
+	// Number of pixels showing the new pattern.
+	k := int(progress*float32(l) + 0.5)
+	if k < 0 {
+		k = 0
+	} else if k > l {
+		k = l
+	}
+	switch s {
+	case StyleWipeLeft:
+		copy(pixels[:l-k], before)
+	case StyleWipeRight:
+		copy(pixels[k:], before[k:])
+	case StyleWipeCenter:
+		o := (l - k) / 2
+		copy(pixels[:o], before)
+		copy(pixels[o+k:], before[o+k:])
+	case StyleSlideLeft:
+		// Iterate backward since the new pattern is moved to the right in place.
+		for i := l - 1; i >= l-k; i-- {
+			pixels[i] = pixels[i-(l-k)]
+		}
+		copy(pixels[:l-k], before[k:])
+	case StyleSlideRight:
+		for i := 0; i < k; i++ {
+			pixels[i] = pixels[i+l-k]
+		}
+		copy(pixels[k:], before)
+	case StyleDissolve:
+		for i := range pixels {
+			if float32(hash32(uint32(i)))/(1<<32) >= progress {
+				pixels[i] = before[i]
+			}
+		}
+	default:
+		pixels.Mix(before, 255.-FloatToUint8(255.*progress))
 	}
 }
 
@@ -143,11 +529,12 @@ func (g *Gradient) NextFrame(pixels Frame, timeMS uint32) {
 //
 // In gets timeMS that is subtracted by OffsetMS.
 type Transition struct {
-	Before     SPattern       // Old pattern that is disappearing
-	After      SPattern       // New pattern to show
-	OffsetMS   uint32         // Offset at which the transiton from Before->In starts
-	DurationMS uint32         // Duration of the transition while both are rendered
-	Transition TransitionType // Type of transition, defaults to EaseOut if not set
+	Before     SPattern        // Old pattern that is disappearing
+	After      SPattern        // New pattern to show
+	OffsetMS   uint32          // Offset at which the transiton from Before->In starts
+	DurationMS uint32          // Duration of the transition while both are rendered
+	Transition TransitionType  // Type of transition, defaults to EaseOut if not set
+	Style      TransitionStyle // Layout of the transition, defaults to StyleFade
 	buf        Frame
 }
 
@@ -169,28 +556,144 @@ func (t *Transition) NextFrame(pixels Frame, timeMS uint32) {
 	}
 	t.buf.reset(len(pixels))
 
-	// TODO(maruel): Add lateral animation and others.
 	if t.Before.Pattern != nil {
 		t.Before.NextFrame(t.buf, timeMS)
 	}
-	pixels.Mix(t.buf, 255.-FloatToUint8(255.*t.Transition.scale(float32(timeMS-t.OffsetMS)/float32(t.DurationMS))))
+	t.Style.blend(pixels, t.buf, t.Transition.scale(float32(timeMS-t.OffsetMS)/float32(t.DurationMS)))
+}
+
+// Cue is one step of a Timeline.
+type Cue struct {
+	Pattern    SPattern
+	StartMS    uint32          // Time at which the transition to this pattern starts
+	DurationMS uint32          // Duration of the transition from the previous cue, or from black for the first one
+	Transition TransitionType  // Type of transition, defaults to EaseOut if not set
+	Style      TransitionStyle // Layout of the transition, defaults to StyleFade
+}
+
+// Timeline plays a scripted show as a flat list of cues.
+//
+// Cues must be sorted by StartMS. Each pattern gets timeMS relative to its own
+// StartMS. The strip is black before the first cue and the last cue stays
+// shown forever unless LoopMS is set.
+type Timeline struct {
+	Cues        []Cue
+	LoopStartMS uint32 // Time to jump back to when reaching LoopMS
+	LoopMS      uint32 // When set, the time jumps back to LoopStartMS when reaching this time
+	buf         Frame
+}
+
+func (t *Timeline) NextFrame(pixels Frame, timeMS uint32) {
+	if t.LoopMS > t.LoopStartMS && timeMS >= t.LoopMS {
+		timeMS = t.LoopStartMS + (timeMS-t.LoopMS)%(t.LoopMS-t.LoopStartMS)
+	}
+	i := len(t.Cues) - 1
+	for i >= 0 && t.Cues[i].StartMS > timeMS {
+		i--
+	}
+	if i < 0 {
+		return
+	}
+	c := &t.Cues[i]
+	since := timeMS - c.StartMS
+	if c.Pattern.Pattern != nil {
+		c.Pattern.NextFrame(pixels, since)
+	}
+	if since >= c.DurationMS {
+		return
+	}
+	t.buf.reset(len(pixels))
+	if i > 0 && t.Cues[i-1].Pattern.Pattern != nil {
+		t.Cues[i-1].Pattern.NextFrame(t.buf, timeMS-t.Cues[i-1].StartMS)
+	}
+	c.Style.blend(pixels, t.buf, c.Transition.scale(float32(since)/float32(c.DurationMS)))
+}
+
+// CycleMode specifies how Cycle plays its frames.
+type CycleMode string
+
+const (
+	CycleLoop     CycleMode = "loop"     // Restarts at the first frame after the last one, default value.
+	CycleOnce     CycleMode = "once"     // Plays all the frames once and stays on the last one.
+	CyclePingPong CycleMode = "pingpong" // Plays the frames forward then backward.
+)
+
+func (c CycleMode) validate() error {
+	switch c {
+	case "", CycleLoop, CycleOnce, CyclePingPong:
+		return nil
+	}
+	return fmt.Errorf("unknown cycle mode %q", string(c))
 }
 
 // Cycle cycles between multiple patterns. It can be used as an animatable
 // looping frame.
 //
-// TODO(maruel): Blend between frames with TransitionType, defaults to step.
 // TODO(maruel): Merge with Loop.
 type Cycle struct {
 	Frames          []SPattern
-	FrameDurationMS uint32
+	FrameDurationMS uint32         // Duration of each frame; the first frame is shown when 0
+	DurationsMS     []uint32       // Optional duration of each frame, overrides FrameDurationMS when set; must be the same length as Frames
+	Transition      TransitionType // Blending toward the next frame during each frame, defaults to no blending
+	Mode            CycleMode      // Defaults to CycleLoop
+	buf             Frame
 }
 
 func (c *Cycle) NextFrame(pixels Frame, timeMS uint32) {
-	if len(c.Frames) == 0 {
+	n := len(c.Frames)
+	if n == 0 {
+		return
+	}
+	// The frames are played as a sequence of m steps. Step s shows
+	// Frames[c.frame(s)].
+	m := n
+	if c.Mode == CyclePingPong && n > 1 {
+		m = 2*n - 2
+	}
+	total := uint32(0)
+	if len(c.DurationsMS) == n {
+		for s := 0; s < m; s++ {
+			total += c.DurationsMS[c.frame(s)]
+		}
+	} else {
+		total = c.FrameDurationMS * uint32(m)
+	}
+	if total == 0 {
+		c.Frames[0].NextFrame(pixels, timeMS)
 		return
 	}
-	c.Frames[int(timeMS/c.FrameDurationMS)%len(c.Frames)].NextFrame(pixels, timeMS)
+	if c.Mode == CycleOnce && timeMS >= total {
+		c.Frames[n-1].NextFrame(pixels, timeMS)
+		return
+	}
+	t := timeMS % total
+	s := 0
+	d := c.FrameDurationMS
+	if len(c.DurationsMS) == n {
+		for d = c.DurationsMS[c.frame(0)]; t >= d; d = c.DurationsMS[c.frame(s)] {
+			t -= d
+			s++
+		}
+	} else {
+		s = int(t / d)
+		t %= d
+	}
+	c.Frames[c.frame(s)].NextFrame(pixels, timeMS)
+	if c.Transition == "" || (c.Mode == CycleOnce && s == n-1) {
+		return
+	}
+	c.buf.reset(len(pixels))
+	c.Frames[c.frame((s+1)%m)].NextFrame(c.buf, timeMS)
+	pixels.Mix(c.buf, FloatToUint8(255.*c.Transition.scale(float32(t)/float32(d))))
+}
+
+// frame returns the index in Frames to show at the step s.
+func (c *Cycle) frame(s int) int {
+	if n := len(c.Frames); s >= n {
+		// Going backward in CyclePingPong.
+		return 2*n - 2 - s
+	}
+	return s
 }
 
 // Loop rotates between all the animations.
@@ -200,37 +703,33 @@ func (c *Cycle) NextFrame(pixels Frame, timeMS uint32) {
 // behind.
 type Loop struct {
 	Patterns             []SPattern
-	DurationShowMS       uint32         // Duration for each pattern to be shown as pure
-	DurationTransitionMS uint32         // Duration of the transition between two patterns
-	Transition           TransitionType // Type of transition, defaults to EaseOut if not set
+	DurationShowMS       uint32          // Duration for each pattern to be shown as pure
+	DurationTransitionMS uint32          // Duration of the transition between two patterns
+	Transition           TransitionType  // Type of transition, defaults to EaseOut if not set
+	Style                TransitionStyle // Layout of the transition, defaults to StyleFade
 	buf                  Frame
 }
 
 func (l *Loop) NextFrame(pixels Frame, timeMS uint32) {
-	l.buf.reset(len(pixels))
-	ds := float32(l.DurationShowMS)
-	dt := float32(l.DurationTransitionMS)
-	cycleDuration := ds + dt
-	cycles := float32(timeMS) / cycleDuration
-	baseIndex := int(cycles)
 	lp := len(l.Patterns)
 	if lp == 0 {
 		return
 	}
-	a := l.Patterns[baseIndex%lp]
-	a.NextFrame(pixels, timeMS)
-	// [0, 1[
-	delta := (cycles - float32(baseIndex))
-	offset := delta * cycleDuration
-	if offset <= ds {
+	cycle := l.DurationShowMS + l.DurationTransitionMS
+	if cycle == 0 {
+		l.Patterns[0].NextFrame(pixels, timeMS)
+		return
+	}
+	index := int((timeMS / cycle) % uint32(lp))
+	offset := timeMS % cycle
+	if offset < l.DurationShowMS {
+		l.Patterns[index].NextFrame(pixels, timeMS)
 		return
 	}
-	b := l.Patterns[(baseIndex+1)%lp]
-	// ]0, 1[
-	intensity := 1. - (offset-ds)/dt
-	// TODO(maruel): Add lateral animation and others.
-	b.NextFrame(l.buf, timeMS)
-	pixels.Mix(l.buf, FloatToUint8(255.*l.Transition.scale(intensity)))
+	l.Patterns[(index+1)%lp].NextFrame(pixels, timeMS)
+	l.buf.reset(len(pixels))
+	l.Patterns[index].NextFrame(l.buf, timeMS)
+	l.Style.blend(pixels, l.buf, l.Transition.scale(float32(offset-l.DurationShowMS)/float32(l.DurationTransitionMS)))
 }
 
 // Rotate rotates a pattern that can also cycle either way.
@@ -239,11 +738,15 @@ func (l *Loop) NextFrame(pixels Frame, timeMS uint32) {
 //
 // Similar to PingPong{} except that it doesn't bounce.
 //
-// Use 5x oversampling with Scale{} to create smoother animation.
+// Set Transition to TransitionLinear to create smoother animation by blending
+// neighbouring pixels at fractional positions.
 type Rotate struct {
 	Child       SPattern
-	MovesPerSec float32 // Expressed in number of light jumps per second.
+	MovesPerSec SValue         // Expressed in number of light jumps per second.
+	PerMoveMS   int32          // Duration of each light jump. Use negative to go left. Takes precedence over MovesPerSec when set.
+	Transition  TransitionType // Blending between two positions, defaults to steps(1,end), which jumps whole pixels.
 	buf         Frame
+	moves       integral
 }
 
 func (r *Rotate) NextFrame(pixels Frame, timeMS uint32) {
@@ -253,12 +756,16 @@ func (r *Rotate) NextFrame(pixels Frame, timeMS uint32) {
 	}
 	r.buf.reset(l)
 	r.Child.NextFrame(r.buf, timeMS)
-	offset := int(float32(timeMS)*0.001*r.MovesPerSec) % l
-	if offset < 0 {
-		offset = l + offset
+	// Calculate the movement going right, then flip it if going left.
+	pos, dir := movePosition(timeMS, &r.moves, r.MovesPerSec, r.PerMoveMS, l)
+	offset := dir * (pos >> 8)
+	w := moveBlend(r.Transition, pos)
+	for i := range pixels {
+		pixels[i] = r.buf[(i-offset+l)%l]
+		if w != 0 {
+			pixels[i].Mix(r.buf[(i-offset-dir+2*l)%l], w)
+		}
 	}
-	copy(pixels[offset:], r.buf)
-	copy(pixels[:offset], r.buf[l-offset:])
 }
 
 // PingPong shows a 'ball' with a trail that bounces from one side to
@@ -267,13 +774,14 @@ func (r *Rotate) NextFrame(pixels Frame, timeMS uint32) {
 // Can be used for a ball, a water wave or K2000 (Knight Rider) style light.
 // The trail can be a Frame or a dynamic pattern.
 //
-// To get smoothed movement, use Scale{} with a 5x factor or so.
-// TODO(maruel): That's a bit inefficient, enable ScalingType here.
+// Set Transition to TransitionLinear to get smoothed movement.
 type PingPong struct {
-	Child SPattern // [0] is the front pixel so the pixels are effectively drawn in reverse order.
-	// TODO(maruel): PerMoveMS uint32
-	MovesPerSec float32 // Expressed in number of light jumps per second.
+	Child       SPattern       // [0] is the front pixel so the pixels are effectively drawn in reverse order.
+	MovesPerSec SValue         // Expressed in number of light jumps per second.
+	PerMoveMS   int32          // Duration of each light jump. Use negative to go backward. Takes precedence over MovesPerSec when set.
+	Transition  TransitionType // Blending between two positions, defaults to steps(1,end), which jumps whole pixels.
 	buf         Frame
+	moves       integral
 }
 
 func (p *PingPong) NextFrame(pixels Frame, timeMS uint32) {
@@ -296,61 +804,286 @@ func (p *PingPong) NextFrame(pixels Frame, timeMS uint32) {
 	//   move == 13 -> "d0123456"
 	//   move 14 -> move 0; "2*(8-1)"
 	cycle := 2 * (len(pixels) - 1)
-	// TODO(maruel): Smoothing with TransitionType, defaults to Step.
-	// TODO(maruel): Convert to uint32.
-	pos := int(float32(timeMS)*0.001*p.MovesPerSec) % cycle
+	if cycle == 0 {
+		pixels[0] = p.buf[0]
+		return
+	}
+	pos, dir := movePosition(timeMS, &p.moves, p.MovesPerSec, p.PerMoveMS, cycle)
+	move := pos >> 8
+	next := (move + 1) % cycle
+	if dir < 0 {
+		move = (cycle - move) % cycle
+		next = (move - 1 + cycle) % cycle
+	}
+	w := moveBlend(p.Transition, pos)
+	for i := range pixels {
+		pixels[i] = p.buf[pingPongIndex(len(pixels), move, i)]
+		if w != 0 {
+			pixels[i].Mix(p.buf[pingPongIndex(len(pixels), next, i)], w)
+		}
+	}
+}
 
+// pingPongIndex returns the index in the Child buffer to use for pixel i at
+// the move pos on a line of l pixels.
+func pingPongIndex(l, pos, i int) int {
 	// Once it works the following code looks trivial but everytime it takes me
 	// an absurd amount of time to rewrite it.
-	if pos >= len(pixels)-1 {
+	if pos >= l-1 {
 		// Head runs left.
 		// pos2 is the position from the right.
-		pos2 := pos + 1 - len(pixels)
+		pos2 := pos + 1 - l
 		// limit is the offset at which order change.
-		limit := len(pixels) - pos2 - 1
-		for i := range pixels {
-			if i < limit {
-				// Going right.
-				pixels[i] = p.buf[len(pixels)-i+pos2-1]
-			} else {
-				// Going left.
-				pixels[i] = p.buf[i-limit]
-			}
+		limit := l - pos2 - 1
+		if i < limit {
+			// Going right.
+			return l - i + pos2 - 1
 		}
-	} else {
-		// Head runs right.
-		for i := range pixels {
-			if i <= pos {
-				// Going right.
-				pixels[i] = p.buf[pos-i]
-			} else {
-				// Going left.
-				pixels[i] = p.buf[pos+i]
-			}
+		// Going left.
+		return i - limit
+	}
+	// Head runs right.
+	if i <= pos {
+		// Going right.
+		return pos - i
+	}
+	// Going left.
+	return pos + i
+}
+
+// movePosition returns the absolute position in 24.8 fixed point in
+// [0, cycle<<8[ and the direction, 1 or -1, for an object moving at
+// movesPerSec, or one move every perMoveMS when set. A negative value means
+// going backward.
+//
+// movesPerSec is integrated over time in moves so an animated speed doesn't
+// make the object jump.
+//
+// The modulo is done in integer or float64 before converting to int so it
+// doesn't overflow on 32 bits platforms and keeps its sub-move precision when
+// timeMS is large.
+func movePosition(timeMS uint32, moves *integral, movesPerSec SValue, perMoveMS int32, cycle int) (int, int) {
+	if perMoveMS != 0 {
+		dir := 1
+		if perMoveMS < 0 {
+			dir = -1
+		}
+		// Convert through int64 so math.MinInt32 doesn't overflow.
+		d := uint32(perMoveMS)
+		if perMoveMS < 0 {
+			d = uint32(-int64(perMoveMS))
 		}
+		moves := int((timeMS / d) % uint32(cycle))
+		frac := int((uint64(timeMS%d) << 8) / uint64(d))
+		return moves<<8 + frac, dir
+	}
+	m := moves.at(movesPerSec, timeMS)
+	dir := 1
+	if m < 0 {
+		dir = -1
+	}
+	f := math.Mod(math.Abs(m), float64(cycle))
+	return int(f*256+0.5) % (cycle << 8), dir
+}
+
+// moveBlend returns the blending intensity toward the next move for the
+// position pos expressed in 24.8 fixed point.
+func moveBlend(t TransitionType, pos int) uint8 {
+	frac := pos & 0xFF
+	if frac == 0 {
+		return 0
+	}
+	if t == "" {
+		t = TransitionStepEnd
+	}
+	return FloatToUint8(255. * t.scale(float32(frac)/256.))
+}
+
+// Mirror renders the child pattern on half of the strip and reflects it on
+// the other half.
+//
+// By default Child[0] is drawn at both ends of the strip. On strips with an
+// odd number of pixels, the center pixel is shared by both halves.
+type Mirror struct {
+	Child      SPattern
+	FromCenter bool // Draws Child[0] at the center instead of at the ends, e.g. for an effect radiating from the center
+	DarkCenter bool // On strips with an odd number of pixels, leaves the center pixel black instead of sharing it
+	buf        Frame
+}
+
+func (m *Mirror) NextFrame(pixels Frame, timeMS uint32) {
+	l := len(pixels)
+	if l == 0 || m.Child.Pattern == nil {
+		return
+	}
+	h := (l + 1) / 2
+	if m.DarkCenter {
+		h = l / 2
+		pixels[h] = Color{}
+	}
+	m.buf.reset(h)
+	m.Child.NextFrame(m.buf, timeMS)
+	for i := 0; i < h; i++ {
+		c := m.buf[i]
+		if m.FromCenter {
+			c = m.buf[h-1-i]
+		}
+		pixels[i] = c
+		pixels[l-1-i] = c
+	}
+}
+
+// Reverse draws the child pattern in reverse order.
+type Reverse struct {
+	Child SPattern
+}
+
+func (r *Reverse) NextFrame(pixels Frame, timeMS uint32) {
+	if r.Child.Pattern == nil {
+		return
+	}
+	r.Child.NextFrame(pixels, timeMS)
+	for i, j := 0, len(pixels)-1; i < j; i, j = i+1, j-1 {
+		pixels[i], pixels[j] = pixels[j], pixels[i]
+	}
+}
+
+// Tile renders the child pattern on a tile of Length pixels and repeats it
+// over the strip.
+type Tile struct {
+	Child  SPattern
+	Length int  // Length of a tile, defaults to the strip length
+	Mirror bool // Reverses every other tile so the tiles join seamlessly
+	buf    Frame
+}
+
+func (t *Tile) NextFrame(pixels Frame, timeMS uint32) {
+	if len(pixels) == 0 || t.Child.Pattern == nil {
+		return
+	}
+	l := t.Length
+	if l <= 0 || l > len(pixels) {
+		l = len(pixels)
+	}
+	t.buf.reset(l)
+	t.Child.NextFrame(t.buf, timeMS)
+	tile(pixels, t.buf, t.Mirror)
+}
+
+// Kaleidoscope splits the strip in Segments parts, each showing the child
+// pattern, reversing every other part.
+//
+// Unlike Tile, the child pattern is sized relative to the strip length.
+type Kaleidoscope struct {
+	Child    SPattern
+	Segments int // Defaults to 2, which mirrors the child at the center
+	buf      Frame
+}
+
+func (k *Kaleidoscope) NextFrame(pixels Frame, timeMS uint32) {
+	if len(pixels) == 0 || k.Child.Pattern == nil {
+		return
+	}
+	s := k.Segments
+	if s <= 0 {
+		s = 2
+	}
+	if s > len(pixels) {
+		s = len(pixels)
+	}
+	k.buf.reset((len(pixels) + s - 1) / s)
+	k.Child.NextFrame(k.buf, timeMS)
+	tile(pixels, k.buf, true)
+}
+
+// tile repeats t over pixels, reversing every other copy if mirror is true.
+func tile(pixels, t Frame, mirror bool) {
+	l := len(t)
+	for i := range pixels {
+		j := i % l
+		if mirror && (i/l)&1 == 1 {
+			j = l - 1 - j
+		}
+		pixels[i] = t[j]
 	}
 }
 
 // Crop draws a subset of a strip, not touching the rest.
+//
+// The area is clipped to the strip so it is safe to animate Start and Length
+// beyond the ends.
 type Crop struct {
-	Child  SPattern
-	Start  int // Starting pixels to skip
-	Length int // Length of the pixels to affect
+	Child    SPattern
+	Start    SValue // Starting pixels to skip
+	Length   SValue // Length of the pixels to affect
+	Relative bool   // Start and Length are fractions of the strip length in [0, 1] instead of a number of pixels
 }
 
 func (s *Crop) NextFrame(pixels Frame, timeMS uint32) {
 	if s.Child.Pattern != nil {
-		s.Child.NextFrame(pixels[s.Start:s.Length], timeMS)
+		start, end := cropBounds(len(pixels), s.Start.Eval(timeMS), s.Length.Eval(timeMS), s.Relative)
+		s.Child.NextFrame(pixels[start:end], timeMS)
+	}
+}
+
+// Zone is one part of the strip for Segments.
+type Zone struct {
+	Name     string // Name of the zone, e.g. "top shelf"; only used to make configurations readable
+	Pattern  SPattern
+	Start    SValue // Starting pixels to skip
+	Length   SValue // Length of the pixels to affect
+	Relative bool   // Start and Length are fractions of the strip length in [0, 1] instead of a number of pixels
+}
+
+// Segments splits the strip in zones, each drawing its own pattern.
+//
+// It is like multiple Crop in a single pattern. Zones are drawn in order, so
+// a later zone overwrites an earlier one where they overlap. Pixels not in any
+// zone are not touched.
+type Segments struct {
+	Zones []Zone
+}
+
+func (s *Segments) NextFrame(pixels Frame, timeMS uint32) {
+	for i := range s.Zones {
+		z := &s.Zones[i]
+		if z.Pattern.Pattern != nil {
+			start, end := cropBounds(len(pixels), z.Start.Eval(timeMS), z.Length.Eval(timeMS), z.Relative)
+			z.Pattern.NextFrame(pixels[start:end], timeMS)
+		}
 	}
 }
 
+// cropBounds returns the [start, end) indexes of a crop clipped to a strip of
+// l pixels.
+func cropBounds(l int, start, length float32, relative bool) (int, int) {
+	if relative {
+		start *= float32(l)
+		length *= float32(l)
+	}
+	s := int(floor(start + 0.5))
+	e := int(floor(start + length + 0.5))
+	if s < 0 {
+		s = 0
+	} else if s > l {
+		s = l
+	}
+	if e < s {
+		e = s
+	} else if e > l {
+		e = l
+	}
+	return s, e
+}
+
 // Mixer is a generic mixer that merges the output from multiple patterns.
 //
 // It doesn't animate.
 type Mixer struct {
 	Patterns []SPattern
-	Weights  []float32 // In theory Sum(Weights) should be 1 but it doesn't need to. For example, mixing a night sky will likely have all of the Weights set to 1.
+	Weights  []SValue // In theory Sum(Weights) should be 1 but it doesn't need to. For example, mixing a night sky will likely have all of the Weights set to 1.
 	bufs     []Frame
+	weights  []float32
 }
 
 func (m *Mixer) NextFrame(pixels Frame, timeMS uint32) {
@@ -364,9 +1097,13 @@ func (m *Mixer) NextFrame(pixels Frame, timeMS uint32) {
 		m.bufs[i].reset(len(pixels))
 	}
 
-	// Draw each pattern.
+	// Draw each pattern and evaluate its weight once for the frame.
+	if len(m.weights) != len(m.Weights) {
+		m.weights = make([]float32, len(m.Weights))
+	}
 	for i := range m.Patterns {
 		m.Patterns[i].NextFrame(m.bufs[i], timeMS)
+		m.weights[i] = m.Weights[i].Eval(timeMS)
 	}
 
 	// Merge patterns.
@@ -375,7 +1112,7 @@ func (m *Mixer) NextFrame(pixels Frame, timeMS uint32) {
 		var r, g, b float32
 		for j := range m.bufs {
 			c := m.bufs[j][i]
-			w := m.Weights[j]
+			w := m.weights[j]
 			r += float32(c.R) * w
 			g += float32(c.G) * w
 			b += float32(c.B) * w
@@ -386,6 +1123,365 @@ func (m *Mixer) NextFrame(pixels Frame, timeMS uint32) {
 	}
 }
 
+// BlendMode specifies how a layer is combined with the layers below it.
+//
+// They are modeled against the CSS mix-blend-mode property.
+type BlendMode string
+
+const (
+	BlendNormal     BlendMode = "normal"     // The layer covers the ones below, default value.
+	BlendAdd        BlendMode = "add"        // Adds the colors, saturating at white.
+	BlendMultiply   BlendMode = "multiply"   // Multiplies the colors, always darker.
+	BlendScreen     BlendMode = "screen"     // Inverse of multiplying the inverted colors, always lighter.
+	BlendMax        BlendMode = "max"        // Keeps the brightest value of each channel.
+	BlendDifference BlendMode = "difference" // Absolute difference between the colors.
+)
+
+func (b BlendMode) validate() error {
+	switch b {
+	case "", BlendNormal, BlendAdd, BlendMultiply, BlendScreen, BlendMax, BlendDifference:
+		return nil
+	}
+	return fmt.Errorf("unknown blend mode %q", string(b))
+}
+
+// blend returns the channel value of src blended over dst.
+func (b BlendMode) blend(dst, src uint8) uint8 {
+	d := uint16(dst)
+	s := uint16(src)
+	switch b {
+	case BlendAdd:
+		if d+s > 255 {
+			return 255
+		}
+		return uint8(d + s)
+	case BlendMultiply:
+		return uint8((d*s + 127) / 255)
+	case BlendScreen:
+		return uint8(255 - ((255-d)*(255-s)+127)/255)
+	case BlendMax:
+		if d > s {
+			return dst
+		}
+		return src
+	case BlendDifference:
+		if d > s {
+			return uint8(d - s)
+		}
+		return uint8(s - d)
+	default:
+		return src
+	}
+}
+
+// composite blends src over dst with the blend mode, then mixes the result
+// with dst according to alpha; 0 means pure dst, 255 means fully blended.
+func (b BlendMode) composite(dst, src Color, alpha uint8) Color {
+	if alpha == 0 {
+		return dst
+	}
+	c := Color{b.blend(dst.R, src.R), b.blend(dst.G, src.G), b.blend(dst.B, src.B)}
+	if alpha == 255 {
+		return c
+	}
+	a := uint16(alpha)
+	a1 := 255 - a
+	return Color{
+		uint8((uint16(dst.R)*a1 + uint16(c.R)*a + 127) / 255),
+		uint8((uint16(dst.G)*a1 + uint16(c.G)*a + 127) / 255),
+		uint8((uint16(dst.B)*a1 + uint16(c.B)*a + 127) / 255),
+	}
+}
+
+// Layer is one layer of Layers.
+type Layer struct {
+	Pattern SPattern
+	Blend   BlendMode // Defaults to BlendNormal
+	Opacity *SValue   // Opacity of the whole layer in [0, 1], defaults to 1 when not set
+	Mask    SPattern  // Optional per pixel alpha; the luminance of each pixel is used, black is transparent and white is opaque
+}
+
+// Layers composites patterns on top of each others, like layers in an image
+// editor.
+//
+// Layers[0] is the bottom layer and is blended over black. Unlike Mixer, a
+// layer can be overlaid without washing out the ones below, e.g. sparkles
+// over a gradient.
+type Layers struct {
+	Layers []Layer
+	buf    Frame
+	mask   Frame
+}
+
+func (l *Layers) NextFrame(pixels Frame, timeMS uint32) {
+	pixels.reset(len(pixels))
+	for i := range l.Layers {
+		y := &l.Layers[i]
+		if y.Pattern.Pattern == nil {
+			continue
+		}
+		opacity := uint16(255)
+		if y.Opacity != nil {
+			if opacity = uint16(FloatToUint8(255. * clamp01(y.Opacity.Eval(timeMS)))); opacity == 0 {
+				continue
+			}
+		}
+		l.buf.reset(len(pixels))
+		y.Pattern.NextFrame(l.buf, timeMS)
+		if y.Mask.Pattern != nil {
+			l.mask.reset(len(pixels))
+			y.Mask.NextFrame(l.mask, timeMS)
+			for j := range pixels {
+				a := (uint16(l.mask[j].luminance())*opacity + 127) / 255
+				pixels[j] = y.Blend.composite(pixels[j], l.buf[j], uint8(a))
+			}
+		} else {
+			for j := range pixels {
+				pixels[j] = y.Blend.composite(pixels[j], l.buf[j], uint8(opacity))
+			}
+		}
+	}
+}
+
+// PaletteMap maps the luminance of a child pattern through a palette.
+//
+// The child pattern is used as a scalar field, e.g. a Gradient from black to
+// white or a Noise.
+type PaletteMap struct {
+	Child   SPattern
+	Palette Palette
+	buf     Frame
+}
+
+func (p *PaletteMap) NextFrame(pixels Frame, timeMS uint32) {
+	if p.Child.Pattern == nil {
+		return
+	}
+	p.buf.reset(len(pixels))
+	p.Child.NextFrame(p.buf, timeMS)
+	for i := range pixels {
+		pixels[i] = p.Palette.At(float32(p.buf[i].luminance()) / 255.)
+	}
+}
+
+// HueShift rotates the hue of a child pattern over time.
+//
+// Saturation and value are preserved, so black, white and grays are not
+// affected.
+type HueShift struct {
+	Child         SPattern
+	Offset        SValue // Initial rotation, in degrees
+	DegreesPerSec SValue // Rotation speed, use negative to rotate the other way
+	degrees       integral
+}
+
+func (h *HueShift) NextFrame(pixels Frame, timeMS uint32) {
+	if h.Child.Pattern == nil {
+		return
+	}
+	h.Child.NextFrame(pixels, timeMS)
+	// The speed is integrated over time so an animated speed doesn't make the
+	// hue jump. Do the modulo in float64 to keep float32 precision.
+	d := math.Mod(float64(h.Offset.Eval(timeMS))+h.degrees.at(h.DegreesPerSec, timeMS), 360)
+	delta := wrapHue(float32(d))
+	if delta == 0 {
+		return
+	}
+	for i := range pixels {
+		hue, s, v := pixels[i].HSV()
+		pixels[i] = MakeColorHSV(hue+delta, s, v)
+	}
+}
+
+// Dim scales the brightness of a child pattern.
+//
+// Unlike APA102.Intensity, it can be applied to a part of the strip with Crop
+// or Layers.
+type Dim struct {
+	Child     SPattern
+	Intensity SValue // In [0, 1]; 0 is black and 1 leaves the child untouched
+}
+
+func (d *Dim) NextFrame(pixels Frame, timeMS uint32) {
+	if d.Child.Pattern == nil {
+		return
+	}
+	d.Child.NextFrame(pixels, timeMS)
+	i := FloatToUint8(255. * d.Intensity.Eval(timeMS))
+	tint(pixels, Color{i, i, i})
+}
+
+// Gamma applies a gamma curve to a child pattern.
+//
+// Values above 1 darken the mid tones, values below 1 brighten them.
+type Gamma struct {
+	Child SPattern
+	Gamma SValue // Defaults to 1, which leaves the child untouched
+	lut   []uint8
+	last  float32
+}
+
+func (g *Gamma) NextFrame(pixels Frame, timeMS uint32) {
+	if g.Child.Pattern == nil {
+		return
+	}
+	g.Child.NextFrame(pixels, timeMS)
+	gamma := g.Gamma.Eval(timeMS)
+	if gamma <= 0 || gamma == 1 {
+		return
+	}
+	// The lookup table is only recalculated when the value changes.
+	if g.lut == nil || g.last != gamma {
+		g.lut = make([]uint8, 256)
+		for i := range g.lut {
+			g.lut[i] = uint8(255.*pow(float32(i)/255., gamma) + 0.5)
+		}
+		g.last = gamma
+	}
+	for i := range pixels {
+		pixels[i] = Color{g.lut[pixels[i].R], g.lut[pixels[i].G], g.lut[pixels[i].B]}
+	}
+}
+
+// Tint multiplies each channel of a child pattern by the colors of another
+// pattern.
+//
+// White leaves the child untouched. Use a Transition or a Timeline between
+// colors to warm up the child over time, or a Gradient to tint each part of
+// the strip differently.
+type Tint struct {
+	Child SPattern
+	Color SPattern // Usually a Color, defaults to white
+	buf   Frame
+}
+
+func (t *Tint) NextFrame(pixels Frame, timeMS uint32) {
+	if t.Child.Pattern == nil {
+		return
+	}
+	t.Child.NextFrame(pixels, timeMS)
+	switch c := t.Color.Pattern.(type) {
+	case nil:
+	case *Color:
+		tint(pixels, *c)
+	default:
+		t.buf.reset(len(pixels))
+		c.NextFrame(t.buf, timeMS)
+		for i := range pixels {
+			pixels[i] = tintColor(pixels[i], t.buf[i])
+		}
+	}
+}
+
+// Kelvin tints a child pattern with the color of a black body at a
+// temperature, e.g. 2700 for a warm incandescent look.
+type Kelvin struct {
+	Child       SPattern
+	Temperature SValue // In Kelvin, in [1000, 40000]; 0 leaves the child untouched
+}
+
+func (k *Kelvin) NextFrame(pixels Frame, timeMS uint32) {
+	if k.Child.Pattern == nil {
+		return
+	}
+	k.Child.NextFrame(pixels, timeMS)
+	t := k.Temperature.Eval(timeMS)
+	if t <= 0 {
+		return
+	}
+	if t < 1000 {
+		t = 1000
+	} else if t > 40000 {
+		t = 40000
+	}
+	tint(pixels, kelvinToColor(uint16(t)))
+}
+
+// Invert inverts the colors of a child pattern.
+type Invert struct {
+	Child SPattern
+}
+
+func (i *Invert) NextFrame(pixels Frame, timeMS uint32) {
+	if i.Child.Pattern == nil {
+		return
+	}
+	i.Child.NextFrame(pixels, timeMS)
+	for j := range pixels {
+		pixels[j] = Color{255 - pixels[j].R, 255 - pixels[j].G, 255 - pixels[j].B}
+	}
+}
+
+// Saturate changes the saturation of a child pattern while keeping its
+// luminance.
+type Saturate struct {
+	Child      SPattern
+	Saturation SValue // 0 is grayscale, 1 leaves the child untouched, more than 1 saturates further
+}
+
+func (s *Saturate) NextFrame(pixels Frame, timeMS uint32) {
+	if s.Child.Pattern == nil {
+		return
+	}
+	s.Child.NextFrame(pixels, timeMS)
+	// 24.8 fixed point.
+	saturation := s.Saturation.Eval(timeMS)
+	f := int32(saturation*256 + 0.5)
+	if saturation < 0 {
+		f = 0
+	}
+	if f == 256 {
+		return
+	}
+	for i := range pixels {
+		l := int32(pixels[i].luminance())
+		pixels[i] = Color{
+			saturateChannel(l, int32(pixels[i].R), f),
+			saturateChannel(l, int32(pixels[i].G), f),
+			saturateChannel(l, int32(pixels[i].B), f),
+		}
+	}
+}
+
+// saturateChannel moves the channel value c away from the luminance l by the
+// factor f expressed in 24.8 fixed point.
+func saturateChannel(l, c, f int32) uint8 {
+	v := l + ((c-l)*f+128*sign(c-l))/256
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+func sign(x int32) int32 {
+	if x < 0 {
+		return -1
+	}
+	return 1
+}
+
+// tint multiplies each channel of the pixels by the respective channel of t.
+func tint(pixels Frame, t Color) {
+	if t == (Color{0xFF, 0xFF, 0xFF}) {
+		return
+	}
+	for i := range pixels {
+		pixels[i] = tintColor(pixels[i], t)
+	}
+}
+
+// tintColor returns c with each channel multiplied by t.
+func tintColor(c, t Color) Color {
+	return Color{
+		uint8((uint16(c.R)*uint16(t.R) + 127) / 255),
+		uint8((uint16(c.G)*uint16(t.G) + 127) / 255),
+		uint8((uint16(c.B)*uint16(t.B) + 127) / 255),
+	}
+}
+
 // Scale adapts a larger or smaller patterns to the Strip size
 //
 // This is useful to create smoother animations or scale down images.
@@ -393,17 +1489,35 @@ type Scale struct {
 	Child  SPattern
 	Scale  ScalingType // Defaults to ScalingLinear
 	Length int         // A buffer of this length will be provided to Child and will be scaled to the actual pixels length
-	Ratio  float32     // Scaling ratio to use, <1 means smaller, >1 means larger. Only one of Length or Ratio can be used
+	Ratio  SValue      // Scaling ratio to use, <1 means smaller, >1 means larger. Only one of Length or Ratio can be used
 	buf    Frame
 }
 
+// maxScaleLength bounds the buffer provided to the child of Scale, since the
+// ratio may come from a Variable.
+const maxScaleLength = 1 << 16
+
 func (s *Scale) NextFrame(pixels Frame, timeMS uint32) {
 	if s.Child.Pattern == nil {
 		return
 	}
 	l := s.Length
 	if l == 0 {
-		l = int(ceil(s.Ratio * float32(len(pixels))))
+		r := s.Ratio.Eval(timeMS) * float32(len(pixels))
+		if r > maxScaleLength {
+			r = maxScaleLength
+		}
+		l = int(ceil(r))
+	}
+	if l <= 0 {
+		// Shrunk to nothing.
+		for i := range pixels {
+			pixels[i] = Color{}
+		}
+		return
+	}
+	if l > maxScaleLength {
+		l = maxScaleLength
 	}
 	s.buf.reset(l)
 	s.Child.NextFrame(s.buf, timeMS)
@@ -5,6 +5,8 @@
 package anim1d
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/maruel/ut"
@@ -27,6 +29,24 @@ func TestTransitionType(t *testing.T) {
 		{TransitionEaseOut, 0.5, 0.6846432685852051},
 		{TransitionType(""), 0.5, 0.6846432685852051},
 		{TransitionLinear, 0.5, 0.5},
+		{TransitionCubicBezier(0.42, 0, 0.58, 1), 0.5, 0.5},
+		{TransitionType("cubic-bezier(0.25, 0.1, 0.25, 1)"), 0.5, 0.8024033904075623},
+		{TransitionType("cubic-bezier(0,0,1,1)"), 0.5, 0.5},
+		{TransitionStepStart, 0., 0.},
+		{TransitionStepStart, 0.1, 1.},
+		{TransitionStepMiddle, 0.49, 0.},
+		{TransitionStepMiddle, 0.5, 1.},
+		{TransitionStepEnd, 0.9, 0.},
+		{TransitionStepEnd, 1., 1.},
+		{TransitionSteps(4, "start"), 0.1, 0.25},
+		{TransitionSteps(4, "start"), 0.5, 0.5},
+		{TransitionSteps(4, "middle"), 0.1, 0.},
+		{TransitionSteps(4, "middle"), 0.2, 0.25},
+		{TransitionSteps(4, "end"), 0.1, 0.},
+		{TransitionSteps(4, "end"), 0.5, 0.5},
+		{TransitionSteps(4, "end"), 0.99, 0.75},
+		{TransitionType("steps(2)"), 0.6, 0.5},
+		{TransitionType("steps(0,end)"), 0.5, 0.6846432685852051},
 	}
 	for i, line := range data {
 		// TODO(maruel): Round a bit.
@@ -34,6 +54,60 @@ func TestTransitionType(t *testing.T) {
 	}
 }
 
+func TestTransitionTypeValidate(t *testing.T) {
+	good := []TransitionType{
+		"",
+		TransitionEase,
+		TransitionStepMiddle,
+		TransitionCubicBezier(0.1, -0.5, 0.9, 1.5),
+		"cubic-bezier( 0 , 0 , 1 , 1 )",
+		"steps(3)",
+		"steps(10, start)",
+	}
+	for i, v := range good {
+		ut.AssertEqualIndex(t, i, nil, v.validate())
+	}
+	bad := []TransitionType{
+		"unknown",
+		"easeinout",
+		"cubic-bezier(",
+		"cubic-bezier()",
+		"cubic-bezier(0,0,1)",
+		"cubic-bezier(0,0,1,1,1)",
+		"cubic-bezier(a,0,1,1)",
+		"cubic-bezier(-0.1,0,1,1)",
+		"cubic-bezier(0,0,1.1,1)",
+		"steps()",
+		"steps(0)",
+		"steps(-1,end)",
+		"steps(1.5,end)",
+		"steps(2,begin)",
+		"steps(2,end,end)",
+	}
+	for i, v := range bad {
+		if v.validate() == nil {
+			t.Fatalf("%d: %q should be invalid", i, v)
+		}
+	}
+
+	// Errors are not cached and the cache is bounded.
+	transitionsLock.RLock()
+	for _, v := range bad {
+		if _, ok := transitions[v]; ok {
+			t.Fatalf("%q was cached", v)
+		}
+	}
+	transitionsLock.RUnlock()
+	for i := 0; i < 2*maxTransitions; i++ {
+		ut.AssertEqual(t, nil, TransitionSteps(i+1, "end").validate())
+	}
+	transitionsLock.Lock()
+	ut.AssertEqual(t, maxTransitions, len(transitions))
+	transitions = map[TransitionType]transitionCurve{}
+	transitionsLock.Unlock()
+	ut.AssertEqual(t, float32(0.5), TransitionSteps(2*maxTransitions, "end").scale(0.5))
+}
+
 func TestScalingType(t *testing.T) {
 	b := make(Frame, 1)
 	for _, v := range []ScalingType{ScalingType(""), ScalingNearestSkip, ScalingNearest, ScalingLinear, ScalingBilinear} {
@@ -42,9 +116,9 @@ func TestScalingType(t *testing.T) {
 		v.scale(b, nil)
 	}
 
-	// TODO(maruel): Add actual tests.
 	red := Color{0xFF, 0x00, 0x00}
 	blue := Color{0x00, 0x00, 0xFF}
+	purple := Color{0x80, 0x00, 0x80}
 	data := []struct {
 		s        ScalingType
 		i        Frame
@@ -55,9 +129,28 @@ func TestScalingType(t *testing.T) {
 		{ScalingLinear, Frame{red, blue}, Frame{red, blue}},
 		{ScalingType(""), Frame{red, blue}, Frame{red, blue}},
 		{ScalingBilinear, Frame{red, blue}, Frame{red, blue}},
+
+		{ScalingNearestSkip, Frame{red, blue}, Frame{{}, red, {}, blue}},
+		{ScalingNearest, Frame{red, blue}, Frame{red, red, blue, blue}},
+		{ScalingNearest, Frame{red, blue, red, blue}, Frame{blue, blue}},
+
+		// Upscaling.
+		{ScalingLinear, Frame{red, blue}, Frame{red, purple, blue}},
+		{ScalingLinear, Frame{red, blue}, Frame{red, {0xBF, 0x00, 0x40}, {0x40, 0x00, 0xBF}, blue}},
+		{ScalingLinear, Frame{red, blue}, Frame{red, {0xE5, 0x00, 0x1A}, purple, {0x1A, 0x00, 0xE5}, blue}},
+		{ScalingLinear, Frame{red, blue, red}, Frame{red, {0x99, 0x00, 0x66}, blue, {0x99, 0x00, 0x66}, red}},
+		{ScalingBilinear, Frame{red, blue}, Frame{red, purple, blue}},
+		{ScalingType(""), Frame{red, blue}, Frame{red, purple, blue}},
+		{ScalingLinear, Frame{red}, Frame{red, red, red}},
+
+		// Downscaling.
+		{ScalingLinear, Frame{red, red, blue, blue}, Frame{red, blue}},
+		{ScalingLinear, Frame{red, blue, red, blue}, Frame{purple}},
+		{ScalingLinear, Frame{red, blue, red}, Frame{{0xAA, 0x00, 0x55}, {0xAA, 0x00, 0x55}}},
+		{ScalingLinear, Frame{red, blue, red, blue, red}, Frame{{0x99, 0x00, 0x66}, {0x99, 0x00, 0x66}}},
+		{ScalingBilinear, Frame{red, red, red, blue, blue, blue}, Frame{red, blue}},
 	}
 	for i, line := range data {
-		// TODO(maruel): Round a bit.
 		out := make(Frame, len(line.expected))
 		line.s.scale(line.i, out)
 		ut.AssertEqualIndex(t, i, line.expected, out)
@@ -72,23 +165,256 @@ func TestGradient(t *testing.T) {
 	testFrame(t, &Gradient{Left: SPattern{a}, Right: SPattern{b}, Transition: TransitionLinear}, expectation{0, Frame{{0x10, 0x10, 0x10}, {0x18, 0x18, 0x18}, {0x20, 0x20, 0x20}}})
 }
 
+func TestMultiGradient(t *testing.T) {
+	a := &Color{0x00, 0x00, 0x00}
+	b := &Color{0x80, 0x80, 0x80}
+	p := &MultiGradient{
+		Stops: []GradientStop{
+			{SPattern{a}, 0, TransitionLinear},
+			{SPattern{b}, 0.5, TransitionLinear},
+			{SPattern{a}, 1, TransitionLinear},
+		},
+	}
+	testFrame(t, p, expectation{0, Frame{{}, {0x40, 0x40, 0x40}, {0x80, 0x80, 0x80}, {0x40, 0x40, 0x40}, {}}})
+	testFrame(t, p, expectation{0, Frame{{0x80, 0x80, 0x80}}})
+
+	// Hard edge and padding before the first and after the last stops.
+	red := Color{0xFF, 0x00, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	p = &MultiGradient{
+		Stops: []GradientStop{
+			{SPattern{&red}, 0.2, TransitionLinear},
+			{SPattern{&red}, 0.5, TransitionLinear},
+			{SPattern{&blue}, 0.5, TransitionLinear},
+			{SPattern{&blue}, 0.8, TransitionLinear},
+		},
+	}
+	testFrame(t, p, expectation{0, Frame{red, red, red, blue, blue, blue}})
+}
+
 func TestTransition(t *testing.T) {
-	// TODO(maruel): Add.
+	a := Color{0x00, 0x00, 0x00}
+	b := Color{0xFF, 0xFF, 0xFF}
+	p := &Transition{Before: SPattern{&a}, After: SPattern{&b}, OffsetMS: 100, DurationMS: 100, Transition: TransitionLinear}
+	e := []expectation{
+		{0, Frame{a}},
+		{100, Frame{a}},
+		{150, Frame{{0x7F, 0x7F, 0x7F}}},
+		{200, Frame{b}},
+		{1000, Frame{b}},
+	}
+	testFrames(t, p, e)
+	p.Style = StyleWipeRight
+	e = []expectation{
+		{100, Frame{a, a, a, a}},
+		{125, Frame{b, a, a, a}},
+		{150, Frame{b, b, a, a}},
+		{200, Frame{b, b, b, b}},
+	}
+	testFrames(t, p, e)
+}
+
+func TestTransitionStyle(t *testing.T) {
+	before := Frame{{0x01, 0, 0}, {0x02, 0, 0}, {0x03, 0, 0}, {0x04, 0, 0}}
+	after := Frame{{0, 0, 0x01}, {0, 0, 0x02}, {0, 0, 0x03}, {0, 0, 0x04}}
+	data := []struct {
+		s        TransitionStyle
+		progress float32
+		expected Frame
+	}{
+		{StyleFade, 0, before},
+		{StyleFade, 1, after},
+		{StyleWipeLeft, 0, before},
+		{StyleWipeLeft, 0.25, Frame{before[0], before[1], before[2], after[3]}},
+		{StyleWipeLeft, 1, after},
+		{StyleWipeRight, 0.75, Frame{after[0], after[1], after[2], before[3]}},
+		{StyleWipeCenter, 0.5, Frame{before[0], after[1], after[2], before[3]}},
+		{StyleWipeCenter, 1, after},
+		{StyleSlideLeft, 0, before},
+		{StyleSlideLeft, 0.25, Frame{before[1], before[2], before[3], after[0]}},
+		{StyleSlideLeft, 0.5, Frame{before[2], before[3], after[0], after[1]}},
+		{StyleSlideLeft, 1, after},
+		{StyleSlideRight, 0.25, Frame{after[3], before[0], before[1], before[2]}},
+		{StyleSlideRight, 0.75, Frame{after[1], after[2], after[3], before[0]}},
+		{StyleSlideRight, 1, after},
+		{StyleDissolve, 0, before},
+		{StyleDissolve, 1, after},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, nil, line.s.validate())
+		pixels := make(Frame, len(after))
+		copy(pixels, after)
+		line.s.blend(pixels, before, line.progress)
+		ut.AssertEqualIndex(t, i, line.expected, pixels)
+	}
+	// Dissolve switches pixels one by one.
+	pixels := make(Frame, 100)
+	b := make(Frame, 100)
+	for i := range b {
+		b[i] = Color{0xFF, 0xFF, 0xFF}
+	}
+	StyleDissolve.blend(pixels, b, 0.5)
+	n := 0
+	for i := range pixels {
+		if pixels[i] == (Color{}) {
+			n++
+		} else {
+			ut.AssertEqual(t, b[i], pixels[i])
+		}
+	}
+	ut.AssertEqual(t, true, n > 30 && n < 70)
+	if TransitionStyle("spiral").validate() == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	red := Color{0xFF, 0x00, 0x00}
+	white := Color{0xFF, 0xFF, 0xFF}
+	p := &Timeline{
+		Cues: []Cue{
+			{Pattern: SPattern{&red}, StartMS: 1000, DurationMS: 1000, Transition: TransitionLinear},
+			{Pattern: SPattern{&white}, StartMS: 3000, DurationMS: 1000, Transition: TransitionLinear},
+			{Pattern: SPattern{&Color{}}, StartMS: 5000},
+		},
+	}
+	e := []expectation{
+		{0, Frame{{}}},
+		{1000, Frame{{}}},
+		{1500, Frame{{0x7F, 0x00, 0x00}}},
+		{2000, Frame{red}},
+		{3000, Frame{red}},
+		{3500, Frame{{0xFF, 0x7F, 0x7F}}},
+		{4000, Frame{white}},
+		{5000, Frame{{}}},
+		{100000, Frame{{}}},
+	}
+	testFrames(t, p, e)
+	p.LoopStartMS = 2000
+	p.LoopMS = 5000
+	e = []expectation{
+		{4999, Frame{white}},
+		{5000, Frame{red}},
+		{6500, Frame{{0xFF, 0x7F, 0x7F}}},
+		{8000, Frame{red}},
+	}
+	testFrames(t, p, e)
+	// Each pattern gets its own time.
+	p = &Timeline{Cues: []Cue{{Pattern: SPattern{&Cycle{Frames: []SPattern{{&red}, {&white}}, FrameDurationMS: 100}}, StartMS: 1050}}}
+	e = []expectation{
+		{1050, Frame{red}},
+		{1149, Frame{red}},
+		{1150, Frame{white}},
+	}
+	testFrames(t, p, e)
 }
 
 func TestCycle(t *testing.T) {
-	// TODO(maruel): Add.
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	c := Color{0x30, 0x30, 0x30}
+	frames := []SPattern{{&a}, {&b}, {&c}}
+	// Doesn't crash when no duration is set.
+	testFrame(t, &Cycle{Frames: frames}, expectation{1000, Frame{a}})
+	p := &Cycle{Frames: frames, FrameDurationMS: 100}
+	e := []expectation{
+		{0, Frame{a}},
+		{99, Frame{a}},
+		{100, Frame{b}},
+		{200, Frame{c}},
+		{300, Frame{a}},
+		{1050, Frame{b}},
+	}
+	testFrames(t, p, e)
+	p.Mode = CycleOnce
+	e = []expectation{
+		{200, Frame{c}},
+		{300, Frame{c}},
+		{100000, Frame{c}},
+	}
+	testFrames(t, p, e)
+	p.Mode = CyclePingPong
+	e = []expectation{
+		{0, Frame{a}},
+		{100, Frame{b}},
+		{200, Frame{c}},
+		{300, Frame{b}},
+		{400, Frame{a}},
+	}
+	testFrames(t, p, e)
+}
+
+func TestCycleDurations(t *testing.T) {
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	c := Color{0x30, 0x30, 0x30}
+	p := &Cycle{Frames: []SPattern{{&a}, {&b}, {&c}}, DurationsMS: []uint32{100, 0, 300}}
+	e := []expectation{
+		{0, Frame{a}},
+		{100, Frame{c}},
+		{399, Frame{c}},
+		{400, Frame{a}},
+	}
+	testFrames(t, p, e)
+	p.Mode = CyclePingPong
+	e = []expectation{
+		{100, Frame{c}},
+		{400, Frame{a}},
+		{500, Frame{c}},
+	}
+	testFrames(t, p, e)
+}
+
+func TestCycleTransition(t *testing.T) {
+	a := Color{0x00, 0x00, 0x00}
+	b := Color{0xFF, 0xFF, 0xFF}
+	p := &Cycle{Frames: []SPattern{{&a}, {&b}}, FrameDurationMS: 100, Transition: TransitionLinear}
+	e := []expectation{
+		{0, Frame{a}},
+		{50, Frame{{0x7F, 0x7F, 0x7F}}},
+		{100, Frame{b}},
+		{150, Frame{{0x80, 0x80, 0x80}}},
+		{200, Frame{a}},
+	}
+	testFrames(t, p, e)
+	// Finishes on the last frame.
+	p.Mode = CycleOnce
+	e = []expectation{
+		{150, Frame{b}},
+		{250, Frame{b}},
+	}
+	testFrames(t, p, e)
 }
 
 func TestLoop(t *testing.T) {
-	// TODO(maruel): Add.
+	a := Color{0x00, 0x00, 0x00}
+	b := Color{0xFF, 0xFF, 0xFF}
+	p := &Loop{Patterns: []SPattern{{&a}, {&b}}, DurationShowMS: 100, DurationTransitionMS: 100, Transition: TransitionLinear}
+	e := []expectation{
+		{0, Frame{a}},
+		{99, Frame{a}},
+		{150, Frame{{0x7F, 0x7F, 0x7F}}},
+		{200, Frame{b}},
+		{350, Frame{{0x80, 0x80, 0x80}}},
+		{400, Frame{a}},
+	}
+	testFrames(t, p, e)
+	p.Style = StyleSlideLeft
+	e = []expectation{
+		{99, Frame{a, a}},
+		{150, Frame{a, b}},
+		{200, Frame{b, b}},
+	}
+	testFrames(t, p, e)
+	// Doesn't crash without durations.
+	testFrame(t, &Loop{Patterns: []SPattern{{&a}, {&b}}}, expectation{100, Frame{a}})
 }
 
 func TestRotate(t *testing.T) {
 	a := Color{10, 10, 10}
 	b := Color{20, 20, 20}
 	c := Color{30, 30, 30}
-	p := &Rotate{Child: SPattern{Frame{a, b, c}}, MovesPerSec: 100}
+	p := &Rotate{Child: SPattern{Frame{a, b, c}}, MovesPerSec: SValue{Const(100)}}
 	e := []expectation{
 		{0, Frame{a, b, c}},
 		{5, Frame{a, b, c}},
@@ -107,7 +433,7 @@ func TestRotateRev(t *testing.T) {
 	a := Color{10, 10, 10}
 	b := Color{20, 20, 20}
 	c := Color{30, 30, 30}
-	p := &Rotate{Child: SPattern{Frame{a, b, c}}, MovesPerSec: -100}
+	p := &Rotate{Child: SPattern{Frame{a, b, c}}, MovesPerSec: SValue{Const(-100)}}
 	e := []expectation{
 		{0, Frame{a, b, c}},
 		{5, Frame{a, b, c}},
@@ -121,6 +447,94 @@ func TestRotateRev(t *testing.T) {
 	testFrames(t, p, e)
 }
 
+func TestRotatePerMove(t *testing.T) {
+	a := Color{10, 10, 10}
+	b := Color{20, 20, 20}
+	c := Color{30, 30, 30}
+	p := &Rotate{Child: SPattern{Frame{a, b, c}}, PerMoveMS: 10}
+	e := []expectation{
+		{0, Frame{a, b, c}},
+		{9, Frame{a, b, c}},
+		{10, Frame{c, a, b}},
+		{20, Frame{b, c, a}},
+		{30, Frame{a, b, c}},
+	}
+	testFrames(t, p, e)
+	p = &Rotate{Child: SPattern{Frame{a, b, c}}, PerMoveMS: -10}
+	e = []expectation{
+		{0, Frame{a, b, c}},
+		{9, Frame{a, b, c}},
+		{10, Frame{b, c, a}},
+		{20, Frame{c, a, b}},
+		{30, Frame{a, b, c}},
+	}
+	testFrames(t, p, e)
+}
+
+func TestRotateSmooth(t *testing.T) {
+	a := Color{10, 10, 10}
+	b := Color{20, 20, 20}
+	c := Color{30, 30, 30}
+	p := &Rotate{Child: SPattern{Frame{a, b, c}}, MovesPerSec: SValue{Const(100)}, Transition: TransitionLinear}
+	e := []expectation{
+		{0, Frame{a, b, c}},
+		{5, Frame{{20, 20, 20}, {15, 15, 15}, {25, 25, 25}}},
+		{10, Frame{c, a, b}},
+		{15, Frame{{25, 25, 25}, {20, 20, 20}, {15, 15, 15}}},
+	}
+	testFrames(t, p, e)
+	p = &Rotate{Child: SPattern{Frame{a, b, c}}, PerMoveMS: -10, Transition: TransitionLinear}
+	e = []expectation{
+		{0, Frame{a, b, c}},
+		{5, Frame{{15, 15, 15}, {25, 25, 25}, {20, 20, 20}}},
+		{10, Frame{b, c, a}},
+	}
+	testFrames(t, p, e)
+}
+
+func TestRotateAnimated(t *testing.T) {
+	a := Color{10, 10, 10}
+	b := Color{20, 20, 20}
+	c := Color{30, 30, 30}
+	// The speed is integrated over time.
+	p := &Rotate{Child: SPattern{Frame{a, b, c}}, MovesPerSec: SValue{&Ramp{From: 0, To: 100, OffsetMS: 10, DurationMS: 0}}}
+	e := []expectation{
+		{0, Frame{a, b, c}},
+		{10, Frame{a, b, c}},
+		{20, Frame{c, a, b}},
+		{30, Frame{b, c, a}},
+		{40, Frame{a, b, c}},
+	}
+	testFrames(t, p, e)
+}
+
+func TestRotateSpeedRamp(t *testing.T) {
+	// Ramping the speed late in the run doesn't make the strip jump.
+	speeds := []SValue{
+		{&Ramp{From: 0, To: 30, OffsetMS: 10000000, DurationMS: 10000, Transition: TransitionEaseInOut}},
+		{&LFO{Min: -30, Max: 30, PeriodMS: 7000}},
+		{&Keyframes{Keyframes: []Keyframe{{10000000, 0, ""}, {10010000, 30, ""}}}},
+	}
+	for i, speed := range speeds {
+		f := make(Frame, 100)
+		f[0] = Color{0xFF, 0xFF, 0xFF}
+		p := &Rotate{Child: SPattern{f}, MovesPerSec: speed}
+		pixels := make(Frame, len(f))
+		last := -1
+		for timeMS := uint32(9990000); timeMS < 10030000; timeMS += 16 {
+			p.NextFrame(pixels, timeMS)
+			pos := 0
+			for pixels[pos] == (Color{}) {
+				pos++
+			}
+			if d := (pos - last + len(f)) % len(f); last != -1 && d > 1 && d < len(f)-1 {
+				t.Fatalf("%d: %d: jumped from %d to %d", i, timeMS, last, pos)
+			}
+			last = pos
+		}
+	}
+}
+
 func TestPingPong(t *testing.T) {
 	a := Color{0x10, 0x10, 0x10}
 	b := Color{0x20, 0x20, 0x20}
@@ -129,7 +543,7 @@ func TestPingPong(t *testing.T) {
 	e := Color{0x50, 0x50, 0x50}
 	f := Color{0x60, 0x60, 0x60}
 
-	p := &PingPong{Child: SPattern{Frame{a, b}}, MovesPerSec: 100}
+	p := &PingPong{Child: SPattern{Frame{a, b}}, MovesPerSec: SValue{Const(100)}}
 	exp := []expectation{
 		{0, Frame{a, b, {}}},
 		{5, Frame{a, b, {}}},
@@ -142,7 +556,7 @@ func TestPingPong(t *testing.T) {
 	}
 	testFrames(t, p, exp)
 
-	p = &PingPong{Child: SPattern{Frame{a, b, c, d, e, f}}, MovesPerSec: 1}
+	p = &PingPong{Child: SPattern{Frame{a, b, c, d, e, f}}, MovesPerSec: SValue{Const(1)}}
 	exp = []expectation{
 		{0, Frame{a, b, c, d}},
 		{500, Frame{a, b, c, d}},
@@ -155,16 +569,365 @@ func TestPingPong(t *testing.T) {
 	}
 	testFrames(t, p, exp)
 
+	p = &PingPong{Child: SPattern{Frame{a, b}}, PerMoveMS: 10}
+	exp = []expectation{
+		{0, Frame{a, b, {}}},
+		{9, Frame{a, b, {}}},
+		{10, Frame{b, a, {}}},
+		{20, Frame{{}, b, a}},
+		{30, Frame{{}, a, b}},
+		{40, Frame{a, b, {}}},
+		{0, Frame{a}},
+		{10, Frame{a}},
+	}
+	testFrames(t, p, exp)
+
+	// Negative goes backward.
+	p = &PingPong{Child: SPattern{Frame{a, b}}, PerMoveMS: -10}
+	exp = []expectation{
+		{0, Frame{a, b, {}}},
+		{10, Frame{{}, a, b}},
+		{20, Frame{{}, b, a}},
+		{30, Frame{b, a, {}}},
+		{40, Frame{a, b, {}}},
+	}
+	testFrames(t, p, exp)
+}
+
+func TestPingPongSmooth(t *testing.T) {
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	p := &PingPong{Child: SPattern{Frame{a, b}}, PerMoveMS: 10, Transition: TransitionLinear}
+	exp := []expectation{
+		{0, Frame{a, b, {}}},
+		{5, Frame{{0x18, 0x18, 0x18}, {0x18, 0x18, 0x18}, {}}},
+		{10, Frame{b, a, {}}},
+		{15, Frame{{0x10, 0x10, 0x10}, {0x18, 0x18, 0x18}, {0x08, 0x08, 0x08}}},
+		{20, Frame{{}, b, a}},
+		{35, Frame{{0x08, 0x08, 0x08}, {0x18, 0x18, 0x18}, {0x10, 0x10, 0x10}}},
+	}
+	testFrames(t, p, exp)
+	p = &PingPong{Child: SPattern{Frame{a, b}}, PerMoveMS: -10, Transition: TransitionLinear}
+	testFrame(t, p, expectation{5, Frame{{0x08, 0x08, 0x08}, {0x18, 0x18, 0x18}, {0x10, 0x10, 0x10}}})
+}
+
+func TestMoveLongRunning(t *testing.T) {
+	a := Color{10, 10, 10}
+	b := Color{20, 20, 20}
+	c := Color{30, 30, 30}
+	// math.MinInt32 doesn't overflow.
+	p := &Rotate{Child: SPattern{Frame{a, b, c}}, PerMoveMS: math.MinInt32}
+	testFrame(t, p, expectation{1 << 31, Frame{b, c, a}})
+	// The sub-move precision is kept after more than a month.
+	p = &Rotate{Child: SPattern{Frame{a, b, c, {}}}, MovesPerSec: SValue{Const(1)}, Transition: TransitionLinear}
+	testFrame(t, p, expectation{3000000501, Frame{{5, 5, 5}, {15, 15, 15}, {25, 25, 25}, {15, 15, 15}}})
+}
+
+func TestMirror(t *testing.T) {
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	c := Color{0x30, 0x30, 0x30}
+	d := Color{0x40, 0x40, 0x40}
+	f := SPattern{Frame{a, b, c, d}}
+	testFrame(t, &Mirror{Child: f}, expectation{0, Frame{a, b, b, a}})
+	testFrame(t, &Mirror{Child: f}, expectation{0, Frame{a, b, c, b, a}})
+	testFrame(t, &Mirror{Child: f, DarkCenter: true}, expectation{0, Frame{a, b, {}, b, a}})
+	testFrame(t, &Mirror{Child: f, FromCenter: true}, expectation{0, Frame{b, a, a, b}})
+	testFrame(t, &Mirror{Child: f, FromCenter: true}, expectation{0, Frame{c, b, a, b, c}})
+	testFrame(t, &Mirror{Child: f, FromCenter: true, DarkCenter: true}, expectation{0, Frame{b, a, {}, a, b}})
+	testFrame(t, &Mirror{Child: f}, expectation{0, Frame{a}})
+}
+
+func TestReverse(t *testing.T) {
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	c := Color{0x30, 0x30, 0x30}
+	testFrame(t, &Reverse{Child: SPattern{Frame{a, b, c}}}, expectation{0, Frame{c, b, a}})
+	testFrame(t, &Reverse{Child: SPattern{Frame{a, b}}}, expectation{0, Frame{b, a}})
+}
+
+func TestTile(t *testing.T) {
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	c := Color{0x30, 0x30, 0x30}
+	f := SPattern{Frame{a, b, c}}
+	testFrame(t, &Tile{Child: f, Length: 3}, expectation{0, Frame{a, b, c, a, b, c, a, b}})
+	testFrame(t, &Tile{Child: f, Length: 3, Mirror: true}, expectation{0, Frame{a, b, c, c, b, a, a, b}})
+	testFrame(t, &Tile{Child: f}, expectation{0, Frame{a, b, c, {}}})
+}
+
+func TestKaleidoscope(t *testing.T) {
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	c := Color{0x30, 0x30, 0x30}
+	f := SPattern{Frame{a, b, c}}
+	testFrame(t, &Kaleidoscope{Child: f}, expectation{0, Frame{a, b, b, a}})
+	testFrame(t, &Kaleidoscope{Child: f, Segments: 3}, expectation{0, Frame{a, b, b, a, a, b}})
+	testFrame(t, &Kaleidoscope{Child: f, Segments: 4}, expectation{0, Frame{a, a, a}})
 }
 
 func TestCrop(t *testing.T) {
-	// TODO(maruel): Add.
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	c := &Crop{Child: SPattern{Frame{a, b}}, Start: SValue{Const(1)}, Length: SValue{Const(2)}}
+	testFrame(t, c, expectation{0, Frame{{}, a, b, {}}})
+	// Clipped to the strip.
+	c.Start = SValue{Const(3)}
+	testFrame(t, c, expectation{0, Frame{{}, {}, {}, a}})
+	c.Start = SValue{Const(-1)}
+	c.Length = SValue{Const(100)}
+	testFrame(t, c, expectation{0, Frame{a, b, {}, {}}})
+	c.Start = SValue{Const(10)}
+	testFrame(t, c, expectation{0, Frame{{}, {}}})
+	// Relative coordinates.
+	c = &Crop{Child: SPattern{&a}, Start: SValue{Const(0.25)}, Length: SValue{Const(0.5)}, Relative: true}
+	testFrame(t, c, expectation{0, Frame{{}, {}, a, a, a, a, {}, {}}})
+	// Growing.
+	c = &Crop{Child: SPattern{&a}, Length: SValue{&Ramp{From: 0, To: 4, DurationMS: 400}}}
+	e := []expectation{
+		{0, Frame{{}, {}, {}, {}}},
+		{100, Frame{a, {}, {}, {}}},
+		{400, Frame{a, a, a, a}},
+	}
+	testFrames(t, c, e)
+}
+
+func TestSegments(t *testing.T) {
+	a := Color{0x10, 0x10, 0x10}
+	b := Color{0x20, 0x20, 0x20}
+	s := &Segments{
+		Zones: []Zone{
+			{Name: "top", Pattern: SPattern{&a}, Length: SValue{Const(2)}},
+			{Name: "bottom", Pattern: SPattern{&b}, Start: SValue{Const(0.5)}, Length: SValue{Const(0.5)}, Relative: true},
+		},
+	}
+	testFrame(t, s, expectation{0, Frame{a, a, {}, {}, b, b, b, b}})
+	testFrame(t, s, expectation{0, Frame{a, b}})
+	testFrame(t, s, expectation{0, Frame{a}})
 }
 
 func TestMixer(t *testing.T) {
-	// TODO(maruel): Add.
+	red := Color{0xFF, 0x00, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	w := &countValue{v: 0.25}
+	m := &Mixer{Patterns: []SPattern{{&red}, {&blue}}, Weights: []SValue{{Const(0.5)}, {w}}}
+	p := Color{0x80, 0x00, 0x40}
+	testFrame(t, m, expectation{0, Frame{p, p, p, p, p, p, p, p}})
+	// The weights are evaluated once per frame, not per pixel.
+	ut.AssertEqual(t, 1, w.n)
+	// Mismatched Weights draws nothing.
+	m.Weights = m.Weights[:1]
+	testFrame(t, m, expectation{0, Frame{{}, {}}})
+}
+
+// countValue counts the number of times it is evaluated.
+type countValue struct {
+	v float32
+	n int
+}
+
+func (c *countValue) Eval(timeMS uint32) float32 {
+	c.n++
+	return c.v
+}
+
+func TestBlendMode(t *testing.T) {
+	dst := Color{0x00, 0x80, 0xFF}
+	src := Color{0x80, 0xC0, 0x40}
+	data := []struct {
+		b        BlendMode
+		expected Color
+	}{
+		{"", Color{0x80, 0xC0, 0x40}},
+		{BlendNormal, Color{0x80, 0xC0, 0x40}},
+		{BlendAdd, Color{0x80, 0xFF, 0xFF}},
+		{BlendMultiply, Color{0x00, 0x60, 0x40}},
+		{BlendScreen, Color{0x80, 0xE0, 0xFF}},
+		{BlendMax, Color{0x80, 0xC0, 0xFF}},
+		{BlendDifference, Color{0x80, 0x40, 0xBF}},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, nil, line.b.validate())
+		ut.AssertEqualIndex(t, i, line.expected, line.b.composite(dst, src, 255))
+		ut.AssertEqualIndex(t, i, dst, line.b.composite(dst, src, 0))
+	}
+	// White and black are the identity of multiply and screen respectively.
+	white := Color{0xFF, 0xFF, 0xFF}
+	ut.AssertEqual(t, dst, BlendMultiply.composite(dst, white, 255))
+	ut.AssertEqual(t, dst, BlendScreen.composite(dst, Color{}, 255))
+	ut.AssertEqual(t, Color{}, BlendDifference.composite(dst, dst, 255))
+	// Half alpha.
+	ut.AssertEqual(t, Color{0x40, 0xA0, 0x9F}, BlendNormal.composite(dst, src, 128))
+	if BlendMode("overlay").validate() == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLayers(t *testing.T) {
+	red := Color{0xFF, 0x00, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	white := Color{0xFF, 0xFF, 0xFF}
+	gray := Color{0x80, 0x80, 0x80}
+	// A sparkle over a gradient doesn't wash it out.
+	l := &Layers{
+		Layers: []Layer{
+			{Pattern: SPattern{Frame{red, red, red, red}}},
+			{Pattern: SPattern{Frame{{}, white, {}, blue}}, Blend: BlendMax},
+		},
+	}
+	testFrame(t, l, expectation{0, Frame{red, white, red, {0xFF, 0x00, 0xFF}}})
+	// Per pixel mask.
+	l = &Layers{
+		Layers: []Layer{
+			{Pattern: SPattern{&red}},
+			{Pattern: SPattern{&blue}, Mask: SPattern{Frame{{}, gray, white}}},
+		},
+	}
+	testFrame(t, l, expectation{0, Frame{red, {0x7F, 0x00, 0x80}, blue}})
+	// Opacity combined with the mask.
+	l.Layers[1].Opacity = &SValue{Const(0.5)}
+	testFrame(t, l, expectation{0, Frame{red, {0xBE, 0x00, 0x41}, {0x7E, 0x00, 0x81}}})
+	// The bottom layer is blended over black.
+	l = &Layers{Layers: []Layer{{Pattern: SPattern{&gray}, Blend: BlendScreen}}}
+	testFrame(t, l, expectation{0, Frame{gray, gray}})
+	// A fade out ends fully transparent.
+	l = &Layers{
+		Layers: []Layer{
+			{Pattern: SPattern{&red}},
+			{Pattern: SPattern{&blue}, Opacity: &SValue{&Ramp{From: 1, To: 0, DurationMS: 100}}},
+		},
+	}
+	testFrames(t, l, []expectation{{0, Frame{blue}}, {50, Frame{{0x80, 0x00, 0x7F}}}, {99, Frame{{0xFB, 0x00, 0x04}}}, {100, Frame{red}}})
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Layers":[{"Pattern":"#ff0000"},{"Pattern":"#0000ff","Opacity":0}],"_type":"Layers"}`), &p))
+	testFrame(t, p.Pattern, expectation{0, Frame{red}})
+}
+
+func TestPaletteMap(t *testing.T) {
+	red := Color{0xFF, 0x00, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	p := &PaletteMap{
+		Child:   SPattern{Frame{{0x00, 0x00, 0x00}, {0x80, 0x80, 0x80}, {0xFF, 0xFF, 0xFF}, {0xFF, 0x00, 0x00}}},
+		Palette: makePalette(Frame{red, blue}),
+	}
+	testFrame(t, p, expectation{0, Frame{red, {0x7E, 0x00, 0x81}, blue, {0xB2, 0x00, 0x4D}}})
+}
+
+func TestHueShiftSpeedRamp(t *testing.T) {
+	p := &HueShift{Child: SPattern{&Color{0xFF, 0x00, 0x00}}, DegreesPerSec: SValue{&Ramp{From: 0, To: 360, OffsetMS: 10000000, DurationMS: 10000}}}
+	pixels := make(Frame, 1)
+	last := float32(-1)
+	for timeMS := uint32(9990000); timeMS < 10030000; timeMS += 16 {
+		p.NextFrame(pixels, timeMS)
+		h, _, _ := pixels[0].HSV()
+		if d := abs(wrapHue(h-last+180) - 180); last != -1 && d > 10 {
+			t.Fatalf("%d: jumped from %g to %g", timeMS, last, h)
+		}
+		last = h
+	}
+}
+
+func TestHueShift(t *testing.T) {
+	red := Color{0xFF, 0x00, 0x00}
+	green := Color{0x00, 0xFF, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	gray := Color{0x80, 0x80, 0x80}
+	p := &HueShift{Child: SPattern{Frame{red, green, gray}}, DegreesPerSec: SValue{Const(120)}}
+	e := []expectation{
+		{0, Frame{red, green, gray}},
+		{1000, Frame{green, blue, gray}},
+		{2000, Frame{blue, red, gray}},
+		{3000, Frame{red, green, gray}},
+		{500, Frame{{0xFF, 0xFF, 0x00}, {0x00, 0xFF, 0xFF}, gray}},
+	}
+	testFrames(t, p, e)
+	p = &HueShift{Child: SPattern{Frame{red}}, Offset: SValue{Const(240)}, DegreesPerSec: SValue{Const(-120)}}
+	e = []expectation{
+		{0, Frame{blue}},
+		{1000, Frame{green}},
+		{2000, Frame{red}},
+	}
+	testFrames(t, p, e)
+}
+
+func TestDim(t *testing.T) {
+	f := Frame{{0xFF, 0x80, 0x00}, {0x10, 0x20, 0x30}}
+	testFrame(t, &Dim{Child: SPattern{f}, Intensity: SValue{Const(1)}}, expectation{0, f})
+	testFrame(t, &Dim{Child: SPattern{f}, Intensity: SValue{Const(0.5)}}, expectation{0, Frame{{0x80, 0x40, 0x00}, {0x08, 0x10, 0x18}}})
+	testFrame(t, &Dim{Child: SPattern{f}}, expectation{0, Frame{{}, {}}})
+}
+
+func TestGamma(t *testing.T) {
+	f := Frame{{0x00, 0x80, 0xFF}}
+	testFrame(t, &Gamma{Child: SPattern{f}}, expectation{0, f})
+	g := &Gamma{Child: SPattern{f}, Gamma: SValue{Const(2.2)}}
+	testFrame(t, g, expectation{0, Frame{{0x00, 0x37, 0xFF}}})
+	g.Gamma = SValue{Const(0.5)}
+	testFrame(t, g, expectation{0, Frame{{0x00, 0xB5, 0xFF}}})
+}
+
+func TestTint(t *testing.T) {
+	f := Frame{{0xFF, 0xFF, 0xFF}, {0x80, 0x80, 0x80}}
+	testFrame(t, &Tint{Child: SPattern{f}, Color: SPattern{&Color{0xFF, 0x80, 0x00}}}, expectation{0, Frame{{0xFF, 0x80, 0x00}, {0x80, 0x40, 0x00}}})
+	testFrame(t, &Tint{Child: SPattern{f}}, expectation{0, f})
+
+	// The tint can be animated, e.g. warming up over time.
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Child":"#ffffff","Color":{"Before":"#ffffff","After":"#ff8000","DurationMS":1000,"Transition":"linear","_type":"Transition"},"_type":"Tint"}`), &p))
+	e := []expectation{
+		{0, Frame{{0xFF, 0xFF, 0xFF}}},
+		{500, Frame{{0xFF, 0xC0, 0x80}}},
+		{1000, Frame{{0xFF, 0x80, 0x00}}},
+	}
+	testFrames(t, p.Pattern, e)
+	// The plain color format is still accepted.
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Child":"#ffffff","Color":"#ff8000","_type":"Tint"}`), &p))
+	testFrame(t, p.Pattern, expectation{0, Frame{{0xFF, 0x80, 0x00}}})
+}
+
+func TestKelvin(t *testing.T) {
+	white := Color{0xFF, 0xFF, 0xFF}
+	testFrame(t, &Kelvin{Child: SPattern{&white}}, expectation{0, Frame{white}})
+	testFrame(t, &Kelvin{Child: SPattern{&white}, Temperature: SValue{Const(2700)}}, expectation{0, Frame{kelvinToColor(2700)}})
+}
+
+func TestInvert(t *testing.T) {
+	testFrame(t, &Invert{Child: SPattern{Frame{{0xFF, 0x80, 0x00}}}}, expectation{0, Frame{{0x00, 0x7F, 0xFF}}})
+}
+
+func TestSaturate(t *testing.T) {
+	red := Color{0xFF, 0x00, 0x00}
+	f := Frame{red, {0x80, 0x80, 0x80}}
+	testFrame(t, &Saturate{Child: SPattern{f}, Saturation: SValue{Const(1)}}, expectation{0, f})
+	// Grayscale keeps the luminance.
+	testFrame(t, &Saturate{Child: SPattern{f}}, expectation{0, Frame{{0x4C, 0x4C, 0x4C}, {0x80, 0x80, 0x80}}})
+	testFrame(t, &Saturate{Child: SPattern{Frame{{0xC0, 0x80, 0x80}}}, Saturation: SValue{Const(2)}}, expectation{0, Frame{{0xED, 0x6D, 0x6D}}})
+	testFrame(t, &Saturate{Child: SPattern{Frame{{0xC0, 0x80, 0x80}}}, Saturation: SValue{Const(0.5)}}, expectation{0, Frame{{0xAA, 0x89, 0x89}}})
 }
 
 func TestScale(t *testing.T) {
-	// TODO(maruel): Add.
+	red := Color{0xFF, 0x00, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	p := &Scale{Child: SPattern{Frame{red, blue}}, Length: 2}
+	testFrame(t, p, expectation{0, Frame{red, {0x80, 0x00, 0x80}, blue}})
+	p = &Scale{Child: SPattern{&Repeated{Frame{red, blue}}}, Ratio: SValue{Const(2)}}
+	testFrame(t, p, expectation{0, Frame{{0x80, 0x00, 0x80}, {0x80, 0x00, 0x80}}})
+
+	// A ratio of 0 or less shows nothing instead of crashing, and a huge one is
+	// bounded.
+	for _, r := range []float32{0, -1, 1e30} {
+		p = &Scale{Child: SPattern{&Color{0xFF, 0x00, 0x00}}, Ratio: SValue{Const(r)}}
+		want := Frame{{}, {}}
+		if r > 0 {
+			want = Frame{red, red}
+		}
+		testFrame(t, p, expectation{0, want})
+	}
+	p = &Scale{Child: SPattern{&Color{0xFF, 0x00, 0x00}}, Length: -1}
+	testFrame(t, p, expectation{0, Frame{{}, {}}})
+	var s SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Child":"#ff0000","Ratio":{"_type":"LFO","Min":-1,"Max":2,"PeriodMS":1000},"_type":"Scale"}`), &s))
+	for timeMS := uint32(0); timeMS < 1000; timeMS += 10 {
+		s.NextFrame(make(Frame, 10), timeMS)
+	}
 }
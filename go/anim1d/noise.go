@@ -0,0 +1,126 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import "math"
+
+// hash32 returns a well distributed 32 bits hash of x.
+//
+// It is used to derive pseudo-random values from a seed and a time slot so
+// animations can be replayed deterministically. It only uses 32 bits integer
+// operations so it is fast on ARM and xtensa.
+//
+// https://nullprogram.com/blog/2018/07/31/
+func hash32(x uint32) uint32 {
+	x ^= x >> 16
+	x *= 0x7feb352d
+	x ^= x >> 15
+	x *= 0x846ca68b
+	x ^= x >> 16
+	return x
+}
+
+// prng is a small pseudo random number generator.
+//
+// Unlike math/rand.Rand, it is free to create so a new one can be seeded on
+// each frame to make the animation a pure function of time.
+type prng uint32
+
+// seedInit is the initial hash to pass to mixSeed.
+const seedInit = 0x9e3779b9
+
+// makePRNG returns a prng seeded from two values.
+func makePRNG(a, b uint32) prng {
+	return seedPRNG(mixSeed(mixSeed(seedInit, a), b))
+}
+
+// mixSeed returns the hash h combined with the value s.
+//
+// It is used to derive seeds in nested loops without rehashing the values
+// shared by the outer loops.
+func mixSeed(h, s uint32) uint32 {
+	return hash32(h ^ s)
+}
+
+// seedPRNG returns a prng seeded with the hash h.
+func seedPRNG(h uint32) prng {
+	if h == 0 {
+		// xorshift is stuck at 0.
+		h = 1
+	}
+	return prng(h)
+}
+
+// next returns the next pseudo random value. It's a xorshift32.
+func (p *prng) next() uint32 {
+	x := uint32(*p)
+	x ^= x << 13
+	x ^= x >> 17
+	x ^= x << 5
+	*p = prng(x)
+	return x
+}
+
+// float returns a pseudo random value in [0, 1[.
+func (p *prng) float() float32 {
+	return float32(p.next()>>8) / (1 << 24)
+}
+
+// intn returns a pseudo random value in [0, n[.
+func (p *prng) intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return int(p.next() % uint32(n))
+}
+
+// valueNoise returns a smooth pseudo random value in [0, 1] for x.
+//
+// Values are random at each integer and interpolated in between so sampling x
+// over time gives a smooth organic variation.
+//
+// x is a float64 so the time can be used directly without losing precision,
+// even after days. The integer part wraps around on 32 bits, which keeps
+// neighbouring values consecutive so there is no discontinuity.
+func valueNoise(seed uint32, x float64) float32 {
+	f := math.Floor(x)
+	i := uint32(int64(f))
+	a := float32(hash32(seed^hash32(i))) / (1 << 32)
+	b := float32(hash32(seed^hash32(i+1))) / (1 << 32)
+	t := float32(x - f)
+	// Smoothstep.
+	t = t * t * (3 - 2*t)
+	return a + (b-a)*t
+}
+
+// randomEvent returns the state of a pseudo-random recurring event.
+//
+// Time is divided in slots of durationMS+averageDelayMS. Each slot contains one
+// event, which starts at a random offset within the slot and ends before the
+// end of the slot. This guarantees there is only one event at a time while
+// being independent of the frame rate.
+//
+// It returns the time since the start of the current event and a prng seeded
+// for this event to decide its characteristics. It returns false if no event
+// is happening at timeMS.
+func randomEvent(seed, timeMS, durationMS, averageDelayMS uint32) (uint32, prng, bool) {
+	if durationMS == 0 {
+		return 0, 0, false
+	}
+	slotMS := durationMS + averageDelayMS
+	slot := timeMS / slotMS
+	// Create a deterministic replay by using the current slot and the seed for
+	// the current event.
+	r := makePRNG(seed, slot)
+	startOffset := uint32(0)
+	if averageDelayMS != 0 {
+		startOffset = r.next() % (averageDelayMS + 1)
+	}
+	since := timeMS - slot*slotMS
+	if since < startOffset || since >= startOffset+durationMS {
+		return 0, 0, false
+	}
+	return since - startOffset, r, true
+}
@@ -44,6 +44,7 @@ type Strip interface {
 type Painter struct {
 	s  Strip
 	c  chan Pattern
+	cs chan TransitionStyle
 	wg sync.WaitGroup
 }
 
@@ -61,6 +62,16 @@ func (p *Painter) SetPattern(s string) error {
 	return nil
 }
 
+// SetTransitionStyle changes the layout of the transition used when changing
+// from one pattern to the next. It defaults to StyleFade.
+func (p *Painter) SetTransitionStyle(s TransitionStyle) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	p.cs <- s
+	return nil
+}
+
 func (p *Painter) Close() error {
 	p.c <- nil
 	p.wg.Wait()
@@ -70,7 +81,7 @@ func (p *Painter) Close() error {
 // MakePainter returns a Painter that manages updating the Patterns to the
 // Strip.
 func MakePainter(s Strip, numLights int) *Painter {
-	p := &Painter{s: s, c: make(chan Pattern)}
+	p := &Painter{s: s, c: make(chan Pattern), cs: make(chan TransitionStyle)}
 	// Tripple buffering.
 	cGen := make(chan Frame, 3)
 	cWrite := make(chan Frame, cap(cGen))
@@ -131,6 +142,9 @@ func (p *Painter) runPattern(cGen, cWrite chan Frame) {
 			ease.After.Pattern = newPat
 			ease.OffsetMS = uint32(since / time.Millisecond)
 
+		case style := <-p.cs:
+			ease.Style = style
+
 		case pixels := <-cGen:
 			for i := range pixels {
 				pixels[i] = Color{}
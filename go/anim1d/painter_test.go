@@ -0,0 +1,71 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maruel/ut"
+)
+
+func TestPainterTransitionStyle(t *testing.T) {
+	s := &recordStrip{}
+	p := MakePainter(s, 10)
+	ut.AssertEqual(t, true, p.SetTransitionStyle("unknown") != nil)
+	ut.AssertEqual(t, nil, p.SetTransitionStyle(StyleWipeRight))
+	ut.AssertEqual(t, nil, p.SetPattern(`"#ffffff"`))
+	white := Color{0xFF, 0xFF, 0xFF}
+	for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+		if f := s.last(); len(f) != 0 && f[0] == white && f[9] == white {
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("transition didn't complete")
+		}
+	}
+	ut.AssertEqual(t, nil, p.Close())
+
+	// With a wipe, some frames are partially white and partially black instead
+	// of being uniformly faded.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wiped := false
+	for _, f := range s.frames {
+		wiped = wiped || (f[0] == white && f[9] == Color{})
+	}
+	ut.AssertEqual(t, true, wiped)
+}
+
+// recordStrip is a Strip that keeps a copy of all the frames written.
+type recordStrip struct {
+	mu     sync.Mutex
+	frames []Frame
+}
+
+func (r *recordStrip) Close() error {
+	return nil
+}
+
+func (r *recordStrip) Write(pixels Frame) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.frames = append(r.frames, append(Frame(nil), pixels...))
+	return nil
+}
+
+func (r *recordStrip) MinDelay() time.Duration {
+	return 0
+}
+
+func (r *recordStrip) last() Frame {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.frames) == 0 {
+		return nil
+	}
+	return r.frames[len(r.frames)-1]
+}
@@ -0,0 +1,142 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+// Emitter emits particles for Particles.
+//
+// Positions and velocities are relative to the strip length so the animation
+// looks the same independent of the number of pixels, e.g. on a thumbnail.
+type Emitter struct {
+	Position    float32 // Where particles are emitted, in [0, 1]
+	Spread      float32 // Random variation of the emission position, relative to the strip length
+	Rate        float32 // Number of emissions per second; 0 disables the emitter
+	Burst       int     // Number of particles per emission, defaults to 1
+	VelocityMin float32 // Minimum initial velocity, in strip length per second; use negative to go toward pixel 0
+	VelocityMax float32 // Maximum initial velocity, in strip length per second
+	LifetimeMS  uint32  // Lifetime of each particle, defaults to 1000
+	Colors      Palette // Color over the life of a particle, from birth to death, defaults to white fading to black
+}
+
+// Particles is a 1D particle system.
+//
+// It can be used for fireworks, rain, meteors or comets. The state of every
+// particle is calculated from its emission time so the animation is a pure
+// function of timeMS and Seed; no simulation state is kept between frames.
+//
+// Particles are blended additively. At most maxParticles particles are drawn
+// per emitter; when more are alive, the oldest emissions are skipped.
+type Particles struct {
+	Emitters []Emitter
+	Gravity  float32 // Acceleration, in strip length per second²; use negative to pull toward pixel 0
+	Friction float32 // Drag in [0, inf[; 1 slows a particle to 37% of its speed after one second
+	Seed     int     // Change it to create a different pseudo-random animation
+}
+
+// maxParticles bounds the work done per emitter on each frame, e.g. with a
+// high Rate and a long LifetimeMS.
+const maxParticles = 1024
+
+var defaultParticleColors = Palette{{Color{0xFF, 0xFF, 0xFF}, 0}, {Color{}, 1}}
+
+func (p *Particles) NextFrame(pixels Frame, timeMS uint32) {
+	for i := range pixels {
+		pixels[i] = Color{}
+	}
+	if len(pixels) == 0 {
+		return
+	}
+	for i := range p.Emitters {
+		p.emit(pixels, timeMS, i)
+	}
+}
+
+// emit draws all the living particles of the emitter i.
+func (p *Particles) emit(pixels Frame, timeMS uint32, i int) {
+	e := &p.Emitters[i]
+	if e.Rate <= 0 {
+		return
+	}
+	periodMS := uint32(1000 / e.Rate)
+	if periodMS == 0 {
+		periodMS = 1
+	}
+	lifetimeMS := e.LifetimeMS
+	if lifetimeMS == 0 {
+		lifetimeMS = 1000
+	}
+	burst := e.Burst
+	if burst <= 0 {
+		burst = 1
+	} else if burst > maxParticles {
+		burst = maxParticles
+	}
+	colors := e.Colors
+	if len(colors) == 0 {
+		colors = defaultParticleColors
+	}
+	// Emissions that happened within the lifetime of a particle.
+	last := timeMS / periodMS
+	first := uint32(0)
+	if timeMS >= lifetimeMS {
+		first = (timeMS-lifetimeMS)/periodMS + 1
+	}
+	if first > last {
+		// No particle is alive.
+		return
+	}
+	if max := uint32(maxParticles / burst); last-first >= max {
+		first = last - max + 1
+	}
+	seed := mixSeed(mixSeed(seedInit, uint32(p.Seed)), uint32(i))
+	l := float32(len(pixels))
+	for n := first; n <= last; n++ {
+		ageMS := timeMS - n*periodMS
+		life := float32(ageMS) / float32(lifetimeMS)
+		age := float32(ageMS) * 0.001
+		h := mixSeed(seed, n)
+		for j := 0; j < burst; j++ {
+			r := seedPRNG(mixSeed(h, uint32(j)))
+			x0 := e.Position + (2*r.float()-1)*e.Spread
+			v0 := e.VelocityMin + r.float()*(e.VelocityMax-e.VelocityMin)
+			x := (x0 + p.move(v0, age)) * l
+			drawParticle(pixels, x, colors.At(life))
+		}
+	}
+}
+
+// move returns the distance traveled after t seconds by a particle with the
+// initial velocity v0.
+func (p *Particles) move(v0, t float32) float32 {
+	g := p.Gravity
+	k := p.Friction
+	if k <= 0 {
+		return v0*t + 0.5*g*t*t
+	}
+	// Solution of dv/dt = g - k*v.
+	return (v0-g/k)*(1-exp(-k*t))/k + g/k*t
+}
+
+// drawParticle adds the color c at the position x in pixels, splitting it
+// between the two nearest pixels for smooth movement.
+func drawParticle(pixels Frame, x float32, c Color) {
+	if x < -1 || x > float32(len(pixels))+1 {
+		return
+	}
+	x -= 0.5
+	f := floor(x)
+	i := int(f)
+	w := uint16((x - f) * 256)
+	if i >= 0 && i < len(pixels) {
+		pixels[i].Add(scaleColor(c, 256-w))
+	}
+	if i+1 >= 0 && i+1 < len(pixels) {
+		pixels[i+1].Add(scaleColor(c, w))
+	}
+}
+
+// scaleColor returns c multiplied by w in [0, 256].
+func scaleColor(c Color, w uint16) Color {
+	return Color{uint8((uint16(c.R)*w + 128) >> 8), uint8((uint16(c.G)*w + 128) >> 8), uint8((uint16(c.B)*w + 128) >> 8)}
+}
@@ -0,0 +1,112 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"testing"
+
+	"github.com/maruel/ut"
+)
+
+func TestParticles(t *testing.T) {
+	w := Color{0xFF, 0xFF, 0xFF}
+	white := Palette{{w, 0}}
+	p := &Particles{
+		Emitters: []Emitter{
+			{Position: 0.05, Rate: 1, VelocityMin: 1, VelocityMax: 1, LifetimeMS: 500, Colors: white},
+		},
+	}
+	e := []expectation{
+		{0, Frame{w, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{100, Frame{{}, w, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{150, Frame{{}, {0x80, 0x80, 0x80}, {0x80, 0x80, 0x80}, {}, {}, {}, {}, {}, {}, {}}},
+		{499, Frame{{}, {}, {}, {}, {0x03, 0x03, 0x03}, {0xFD, 0xFD, 0xFD}, {}, {}, {}, {}}},
+		{500, Frame{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{1000, Frame{w, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+	}
+	testFrames(t, p, e)
+
+	// Two bursts alive at the same time, going left.
+	p = &Particles{
+		Emitters: []Emitter{
+			{Position: 0.95, Rate: 10, VelocityMin: -1, VelocityMax: -1, LifetimeMS: 200, Colors: white},
+		},
+	}
+	testFrame(t, p, expectation{175, Frame{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, w, {}, w, {}, {}}})
+}
+
+func TestParticlesColors(t *testing.T) {
+	p := &Particles{
+		Emitters: []Emitter{{Position: 0.5, Rate: 1}},
+	}
+	// Defaults to white fading to black.
+	testFrame(t, p, expectation{0, Frame{{0xFF, 0xFF, 0xFF}}})
+	testFrame(t, p, expectation{500, Frame{{0x80, 0x80, 0x80}}})
+	testFrame(t, p, expectation{999, Frame{{}}})
+}
+
+func TestParticlesMove(t *testing.T) {
+	p := &Particles{Gravity: -2}
+	ut.AssertEqual(t, float32(-1), p.move(0, 1))
+	ut.AssertEqual(t, float32(0), p.move(1, 1))
+	// Friction limits the distance traveled.
+	p = &Particles{Friction: 2}
+	ut.AssertEqual(t, true, abs(p.move(1, 100)-0.5) < 0.001)
+	ut.AssertEqual(t, true, p.move(1, 0.1) < 0.1)
+	// Terminal velocity.
+	p = &Particles{Gravity: -1, Friction: 1}
+	ut.AssertEqual(t, true, abs(p.move(0, 101)-p.move(0, 100)+1) < 0.001)
+}
+
+func TestParticlesMax(t *testing.T) {
+	// One emission per millisecond living 5 seconds would draw 5000 particles up
+	// to the middle of the strip; only the newest 1024 are drawn.
+	p := &Particles{
+		Emitters: []Emitter{
+			{Rate: 1000, VelocityMin: 0.1, VelocityMax: 0.1, LifetimeMS: 5000, Colors: Palette{{Color{0x10, 0x10, 0x10}, 0}}},
+		},
+	}
+	pixels := make(Frame, 100)
+	p.NextFrame(pixels, 4000000000)
+	for i, c := range pixels {
+		if lit := c != (Color{}); lit != (i < 11) {
+			t.Fatalf("%d: unexpected color %v", i, c)
+		}
+	}
+
+	// A huge burst doesn't hang.
+	p.Emitters[0].Burst = 1 << 30
+	p.NextFrame(pixels, 4000000000)
+
+	// Nothing is drawn between emissions when the lifetime is shorter than the
+	// emission period.
+	p = &Particles{
+		Emitters: []Emitter{
+			{Position: 0.5, Rate: 1, LifetimeMS: 100, Colors: Palette{{Color{0xFF, 0x00, 0x00}, 0}}},
+		},
+	}
+	testFrame(t, p, expectation{5000500, make(Frame, 10)})
+	testFrame(t, p, expectation{5000050, Frame{{}, {}, {}, {}, {0x80, 0, 0}, {0x80, 0, 0}, {}, {}, {}, {}}})
+}
+
+func TestParticlesDeterministic(t *testing.T) {
+	p := &Particles{
+		Emitters: []Emitter{
+			{Position: 0.5, Spread: 0.5, Rate: 3, Burst: 20, VelocityMin: -0.5, VelocityMax: 0.5, LifetimeMS: 2000},
+		},
+		Gravity:  -0.3,
+		Friction: 1,
+	}
+	a := make(Frame, 50)
+	b := make(Frame, 50)
+	p.NextFrame(a, 123456)
+	(&Particles{Emitters: p.Emitters, Gravity: p.Gravity, Friction: p.Friction}).NextFrame(b, 123456)
+	ut.AssertEqual(t, a, b)
+	p.Seed = 1
+	p.NextFrame(b, 123456)
+	ut.AssertEqual(t, false, a.isEqual(b))
+	// Something is drawn.
+	ut.AssertEqual(t, false, a.isEqual(make(Frame, 50)))
+}
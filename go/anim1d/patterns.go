@@ -4,6 +4,8 @@
 
 package anim1d
 
+import "math"
+
 // Color shows a single color on all lights. It knows how to renders itself
 // into a frame.
 //
@@ -91,25 +93,58 @@ func (f Frame) isEqual(rhs Frame) bool {
 	return true
 }
 
-// MakeRainbow renders rainbow colors.
+// Rainbow renders rainbow colors.
+//
+// The default value shows the whole visible spectrum without moving and is
+// serialized as the string "Rainbow".
 type Rainbow struct {
-	buf Frame
+	Start      SValue  // Position in the spectrum of the first pixel in [0, 1]; 0 is violet and 1 is red
+	Span       *SValue // Fraction of the spectrum shown over the strip, negative reverses the order, defaults to 1 when not set
+	Speed      SValue  // Scrolling speed, in spectrum per second, use negative to scroll the other way
+	Saturation *SValue // Saturation in [0, 1], defaults to 1 when not set
+	Brightness *SValue // Brightness in [0, 1], defaults to 1 when not set
+	pos        []float32
+	scroll     integral
 }
 
 func (r *Rainbow) NextFrame(pixels Frame, timeMS uint32) {
-	if len(r.buf) != len(pixels) {
-		r.buf.reset(len(pixels))
-		const start = 380
-		const end = 781
-		const delta = end - start
+	if len(r.pos) != len(pixels) {
+		// Use a logarithmic scale so each color gets a visually similar length.
+		r.pos = make([]float32, len(pixels))
 		scale := logn(2)
 		step := 1. / float32(len(pixels))
 		for i := range pixels {
-			j := log1p(float32(len(pixels)-i-1)*step) / scale
-			r.buf[i] = waveLength2RGB(int(start + delta*(1-j)))
+			r.pos[i] = 1 - log1p(float32(len(pixels)-i-1)*step)/scale
 		}
 	}
-	copy(pixels, r.buf)
+	span := evalOr(r.Span, 1, timeMS)
+	offset := r.Start.Eval(timeMS)
+	if r.Speed.Value != nil {
+		// The speed is integrated over time; do the modulo in float64 to keep
+		// float32 precision.
+		offset += float32(math.Mod(r.scroll.at(r.Speed, timeMS), 1))
+	}
+	white := FloatToUint8(255. * (1 - evalOr(r.Saturation, 1, timeMS)))
+	brightness := FloatToUint8(255. * evalOr(r.Brightness, 1, timeMS))
+	const start = 380
+	const end = 781
+	const delta = end - start
+	for i := range pixels {
+		q := offset + span*r.pos[i]
+		if q < 0 || q > 1 {
+			q = mod(q, 1)
+		}
+		c := waveLength2RGB(int(start + delta*q))
+		if white != 0 {
+			c.Mix(Color{255, 255, 255}, white)
+		}
+		if brightness != 255 {
+			d := Color{}
+			d.Mix(c, brightness)
+			c = d
+		}
+		pixels[i] = c
+	}
 }
 
 // waveLengthToRGB returns a color over a rainbow.
@@ -146,6 +181,228 @@ func waveLength2RGB(w int) (c Color) {
 	return
 }
 
+// MakeColorHSV returns a color from hue in degrees, saturation and value in
+// [0, 1].
+func MakeColorHSV(h, s, v float32) Color {
+	h = wrapHue(h)
+	s = clamp01(s)
+	v = clamp01(v)
+	c := v * s
+	return hueToColor(h, c, v-c)
+}
+
+// MakeColorHSL returns a color from hue in degrees, saturation and lightness
+// in [0, 1].
+func MakeColorHSL(h, s, l float32) Color {
+	h = wrapHue(h)
+	s = clamp01(s)
+	l = clamp01(l)
+	c := (1 - abs(2*l-1)) * s
+	return hueToColor(h, c, l-c/2)
+}
+
+// HSV returns the hue in degrees in [0, 360[, saturation and value in [0, 1].
+func (c *Color) HSV() (h, s, v float32) {
+	h, max, min := c.hue()
+	if max != 0 {
+		s = (max - min) / max
+	}
+	return h, s, max
+}
+
+// HSL returns the hue in degrees in [0, 360[, saturation and lightness in
+// [0, 1].
+func (c *Color) HSL() (h, s, l float32) {
+	h, max, min := c.hue()
+	l = (max + min) / 2
+	if max != min {
+		s = (max - min) / (1 - abs(2*l-1))
+	}
+	return h, s, l
+}
+
+// hue returns the hue in degrees and the maximum and minimum channels in
+// [0, 1].
+func (c *Color) hue() (h, max, min float32) {
+	r := float32(c.R) / 255
+	g := float32(c.G) / 255
+	b := float32(c.B) / 255
+	max, min = r, r
+	if g > max {
+		max = g
+	}
+	if b > max {
+		max = b
+	}
+	if g < min {
+		min = g
+	}
+	if b < min {
+		min = b
+	}
+	d := max - min
+	switch {
+	case d == 0:
+	case max == r:
+		h = 60 * (g - b) / d
+	case max == g:
+		h = 60 * ((b-r)/d + 2)
+	default:
+		h = 60 * ((r-g)/d + 4)
+	}
+	return wrapHue(h), max, min
+}
+
+// hueToColor returns the color for hue h in [0, 360[, chroma c and an
+// offset m added to each channel.
+func hueToColor(h, c, m float32) Color {
+	x := c * (1 - abs(mod(h/60, 2)-1))
+	var r, g, b float32
+	switch int(h / 60) {
+	case 0:
+		r, g = c, x
+	case 1:
+		r, g = x, c
+	case 2:
+		g, b = c, x
+	case 3:
+		g, b = x, c
+	case 4:
+		r, b = x, c
+	default:
+		r, b = c, x
+	}
+	return Color{unitToUint8(r + m), unitToUint8(g + m), unitToUint8(b + m)}
+}
+
+// wrapHue returns the hue in [0, 360[.
+func wrapHue(h float32) float32 {
+	h = mod(h, 360)
+	if h >= 360 {
+		// Rounding of small negative values.
+		h = 0
+	}
+	return h
+}
+
+// unitToUint8 converts [0, 1] to [0, 255] with rounding.
+func unitToUint8(x float32) uint8 {
+	return uint8(clamp01(x)*255 + 0.5)
+}
+
+func clamp01(x float32) float32 {
+	if x < 0 {
+		return 0
+	}
+	if x > 1 {
+		return 1
+	}
+	return x
+}
+
+// luminance returns the perceived brightness of the color.
+func (c *Color) luminance() uint8 {
+	// Rec. 601 weights in 8 bits fixed point; they sum to 256.
+	return uint8((77*uint32(c.R) + 150*uint32(c.G) + 29*uint32(c.B)) >> 8)
+}
+
+// PaletteStop is one color of a Palette.
+type PaletteStop struct {
+	Color    Color
+	Position float32 // Position of the color in the palette, in [0, 1]
+}
+
+// Palette maps a value in [0, 1] to a color.
+//
+// Stops must be sorted by Position. It can be serialized as the name of one
+// of the built-in palettes, like "rainbow", as a Frame string "LRRGGBB..." of
+// evenly spaced colors or as a list of stops.
+type Palette []PaletteStop
+
+// namedPalettes are the built-in palettes.
+var namedPalettes = map[string]Palette{
+	"aurora":  makePalette(Frame{{0x00, 0xFF, 0x40}, {0x00, 0xC0, 0xA0}, {0x80, 0x20, 0xFF}}),
+	"fire":    makePalette(Frame{{0x00, 0x00, 0x00}, {0xA0, 0x00, 0x00}, {0xFF, 0x60, 0x00}, {0xFF, 0xC0, 0x00}, {0xFF, 0xFF, 0xC0}}),
+	"forest":  makePalette(Frame{{0x00, 0x20, 0x00}, {0x00, 0x60, 0x00}, {0x20, 0x80, 0x20}, {0x60, 0xA0, 0x40}, {0xC0, 0xE0, 0x80}}),
+	"lava":    makePalette(Frame{{0x00, 0x00, 0x00}, {0x80, 0x00, 0x00}, {0xFF, 0x00, 0x00}, {0xFF, 0x80, 0x00}, {0xFF, 0xFF, 0x80}}),
+	"ocean":   makePalette(Frame{{0x00, 0x00, 0x20}, {0x00, 0x00, 0xA0}, {0x00, 0x60, 0xC0}, {0x00, 0xC0, 0xC0}, {0xC0, 0xFF, 0xFF}}),
+	"rainbow": makePalette(Frame{{0xFF, 0x00, 0x00}, {0xFF, 0xFF, 0x00}, {0x00, 0xFF, 0x00}, {0x00, 0xFF, 0xFF}, {0x00, 0x00, 0xFF}, {0xFF, 0x00, 0xFF}, {0xFF, 0x00, 0x00}}),
+}
+
+// makePalette returns a Palette of evenly spaced colors.
+func makePalette(f Frame) Palette {
+	p := make(Palette, len(f))
+	for i, c := range f {
+		p[i].Color = c
+		if len(f) > 1 {
+			p[i].Position = float32(i) / float32(len(f)-1)
+		}
+	}
+	return p
+}
+
+// At returns the color at position f in [0, 1].
+func (p Palette) At(f float32) Color {
+	if len(p) == 0 {
+		return Color{}
+	}
+	if f <= p[0].Position {
+		return p[0].Color
+	}
+	for i := 1; i < len(p); i++ {
+		if f < p[i].Position {
+			a := p[i-1]
+			c := a.Color
+			c.Mix(p[i].Color, FloatToUint8(255.*(f-a.Position)/(p[i].Position-a.Position)))
+			return c
+		}
+	}
+	return p[len(p)-1].Color
+}
+
+// Noise draws smooth pseudo-random noise in grayscale.
+//
+// It is meant to be used as a scalar field, for example with PaletteMap.
+type Noise struct {
+	Scale *SValue // Number of features over the strip length, defaults to 4 when not set
+	Speed SValue  // Speed at which the noise evolves, in features per second
+	Seed  int     // Change it to create a different pseudo-random animation
+	t     integral
+}
+
+func (n *Noise) NextFrame(pixels Frame, timeMS uint32) {
+	scale := evalOr(n.Scale, 4, timeMS)
+	t := n.t.at(n.Speed, timeMS)
+	for i := range pixels {
+		x := float64(scale) * float64(i) / float64(len(pixels))
+		v := FloatToUint8(255. * valueNoise(uint32(n.Seed), x+t))
+		pixels[i] = Color{v, v, v}
+	}
+}
+
+// kelvinToColor returns the color of a black body at a temperature in Kelvin.
+//
+// It is valid for [1000, 40000].
+//
+// This code was inspired by public domain code on the internet.
+func kelvinToColor(k uint16) (c Color) {
+	t := float32(k) / 100
+	if t <= 66 {
+		c.R = 255
+		c.G = FloatToUint8(99.4708025861*logn(t) - 161.1195681661)
+	} else {
+		c.R = FloatToUint8(329.698727446 * pow(t-60, -0.1332047592))
+		c.G = FloatToUint8(288.1221695283 * pow(t-60, -0.0755148492))
+	}
+	switch {
+	case t >= 66:
+		c.B = 255
+	case t > 19:
+		c.B = FloatToUint8(138.5177312231*logn(t-10) - 305.0447927307)
+	}
+	return
+}
+
 // Repeated repeats a Frame to fill the pixels.
 type Repeated struct {
 	Frame Frame
@@ -159,3 +416,337 @@ func (r *Repeated) NextFrame(pixels Frame, timeMS uint32) {
 		copy(pixels[i:], r.Frame)
 	}
 }
+
+// WishingStar draws a wishing star from time to time.
+//
+// It will only draw one star at a time. To increase the likelihood of getting
+// many simultaneously, create multiple instances with different Seed and use
+// Mixer with Weights of 1.
+//
+// The animation is a pure function of timeMS and Seed so the same star is
+// drawn on every device at the same time.
+type WishingStar struct {
+	Color          Color   // Color of the head of the star
+	Length         int     // Length of the trail in pixels
+	MovesPerSec    float32 // Speed of the star expressed in pixels per second
+	DurationMS     uint32  // Duration of a star
+	AverageDelayMS uint32  // Average delay between the end of a star and the start of the next one
+	Seed           int     // Change it to create a different pseudo-random animation
+}
+
+func (w *WishingStar) NextFrame(pixels Frame, timeMS uint32) {
+	for i := range pixels {
+		pixels[i] = Color{}
+	}
+	if len(pixels) == 0 {
+		return
+	}
+	since, r, ok := randomEvent(uint32(w.Seed), timeMS, w.DurationMS, w.AverageDelayMS)
+	if !ok {
+		return
+	}
+	// Always calculate things in the same order to keep the calculation
+	// deterministic.
+	startPos := r.float() * float32(len(pixels))
+	intensity := 0.5 + 0.5*r.float()
+	orientation := r.intn(2)
+	// [0, 1[
+	life := float32(since) / float32(w.DurationMS)
+	// Fade in then fade out.
+	intensity *= 4 * life * (1 - life)
+	head := startPos + float32(since)*0.001*w.MovesPerSec
+	trail := float32(w.Length)
+	if trail < 1 {
+		trail = 1
+	}
+	for i := range pixels {
+		// Distance from the head, going toward the trail.
+		d := head - float32(i)
+		if orientation == 1 {
+			d = float32(i) - (float32(len(pixels)-1) - head)
+		}
+		var f float32
+		switch {
+		case d <= -1 || d >= trail:
+			continue
+		case d < 0:
+			// Partially lit pixel in front of the head.
+			f = 1 + d
+		default:
+			f = 1 - d/trail
+		}
+		pixels[i].Mix(w.Color, FloatToUint8(255.*f*intensity))
+	}
+}
+
+// StarType is the way a star of NightStars twinkles.
+type StarType int
+
+const (
+	StarSteady  StarType = iota // Constant intensity
+	StarPulse                   // Slowly pulses over a few seconds
+	StarFlicker                 // Flickers, like a star low on the horizon
+	starTypes
+)
+
+// NightStar describes a single star.
+//
+// NightStars uses it for each of its stars but it can also be used alone.
+type NightStar struct {
+	Intensity   uint8
+	Type        StarType
+	Temperature uint16 // Color temperature in Kelvin
+	Seed        int    // Changes the pulse period and the flickering
+}
+
+// NextFrame draws the star on all the pixels.
+func (n *NightStar) NextFrame(pixels Frame, timeMS uint32) {
+	seed := uint32(n.Seed)
+	f := float32(1)
+	switch n.Type {
+	case StarPulse:
+		// Period between 2s and 8s.
+		period := 2000 + seed%6000
+		phase := float32((timeMS+seed)%period) / float32(period)
+		f = 0.625 + 0.375*sin(2*math.Pi*phase)
+	case StarFlicker:
+		// Changes around 12 times per second.
+		f = 0.25 + 0.75*valueNoise(seed, float64(timeMS)/80)
+	}
+	c := Color{}
+	c.Mix(kelvinToColor(n.Temperature), FloatToUint8(f*float32(n.Intensity)))
+	for i := range pixels {
+		pixels[i] = c
+	}
+}
+
+// NightStars draws twinkling stars.
+//
+// The position, intensity, type and temperature of each star is derived from
+// Seed and the twinkling is a pure function of timeMS, so the result is the
+// same independent of the frame rate and on every device.
+type NightStars struct {
+	Density   *SValue // Ratio of the pixels with a star, defaults to 0.3 when not set
+	Intensity uint8   // Maximum intensity of a star, defaults to 255
+	Seed      int     // Change it to create a different pseudo-random animation.
+}
+
+func (e *NightStars) NextFrame(pixels Frame, timeMS uint32) {
+	density := evalOr(e.Density, 0.3, timeMS)
+	for i := range pixels {
+		pixels[i] = Color{}
+		if s, ok := e.star(i, density); ok {
+			s.NextFrame(pixels[i:i+1], timeMS)
+		}
+	}
+}
+
+// star returns the star at pixel i, if any.
+func (e *NightStars) star(i int, density float32) (NightStar, bool) {
+	max := e.Intensity
+	if max == 0 {
+		max = 255
+	}
+	// Always calculate things in the same order to keep the calculation
+	// deterministic.
+	r := makePRNG(uint32(e.Seed), uint32(i))
+	if r.float() >= density {
+		return NightStar{}, false
+	}
+	return NightStar{
+		// Most stars are dim.
+		Intensity:   uint8(uint32(max) * (32 + uint32(r.intn(224))) * (32 + uint32(r.intn(224))) / (255 * 255)),
+		Type:        StarType(r.intn(int(starTypes))),
+		Temperature: uint16(2500 + r.intn(9500)),
+		Seed:        int(r.next()),
+	}, true
+}
+
+// Supernova draws a star exploding from time to time.
+//
+// The star flashes then slowly fades while its glow expands. It will only
+// draw one supernova at a time.
+type Supernova struct {
+	Color          Color  // Color of the explosion
+	Size           int    // Radius of the glow at the end of the explosion, in pixels
+	DurationMS     uint32 // Duration of an explosion
+	AverageDelayMS uint32 // Average delay between the end of an explosion and the start of the next one
+	Seed           int    // Change it to create a different pseudo-random animation
+}
+
+func (s *Supernova) NextFrame(pixels Frame, timeMS uint32) {
+	for i := range pixels {
+		pixels[i] = Color{}
+	}
+	if len(pixels) == 0 {
+		return
+	}
+	since, r, ok := randomEvent(uint32(s.Seed), timeMS, s.DurationMS, s.AverageDelayMS)
+	if !ok {
+		return
+	}
+	center := r.intn(len(pixels))
+	// [0, 1[
+	life := float32(since) / float32(s.DurationMS)
+	// Flash during the first 10% then fade out.
+	intensity := life * 10
+	if life > 0.1 {
+		intensity = (1 - life) * (1 - life) / 0.81
+	}
+	// The glow expands quickly at first then slows down.
+	radius := float32(s.Size) * (1 - (1-life)*(1-life))
+	for i := range pixels {
+		d := abs(float32(i - center))
+		f := float32(1)
+		if d != 0 {
+			if d >= radius+1 {
+				continue
+			}
+			// The glow is dimmer than the center.
+			f = 0.5 * (1 - d/(radius+1))
+		}
+		pixels[i].Mix(s.Color, FloatToUint8(255.*f*intensity))
+	}
+}
+
+// NightSky draws a night sky.
+//
+// It layers the aurora, the stars, the wishing stars and the supernovae by
+// adding them together like a Mixer with all Weights set to 1 except for the
+// aurora.
+//
+// Each layer is deterministic so the whole scene is a pure function of
+// timeMS.
+type NightSky struct {
+	Aurore          Aurore
+	AuroreIntensity SValue // Weight of the aurora, 0 disables it
+	Stars           NightStars
+	WishingStar     WishingStar // Set DurationMS to 0 to disable
+	Supernova       Supernova   // Set DurationMS to 0 to disable
+	mixer           Mixer
+}
+
+func (n *NightSky) NextFrame(pixels Frame, timeMS uint32) {
+	n.mixer.Patterns = append(n.mixer.Patterns[:0], SPattern{&n.Stars}, SPattern{&n.WishingStar}, SPattern{&n.Supernova})
+	one := SValue{Const(1)}
+	n.mixer.Weights = append(n.mixer.Weights[:0], one, one, one)
+	if n.AuroreIntensity.Value != nil && n.AuroreIntensity.Value != Const(0) {
+		n.mixer.Patterns = append(n.mixer.Patterns, SPattern{&n.Aurore})
+		n.mixer.Weights = append(n.mixer.Weights, n.AuroreIntensity)
+	}
+	n.mixer.NextFrame(pixels, timeMS)
+}
+
+// Aurore draws an aurora borealis: bands of light that slowly drift and morph.
+//
+// The pattern is calculated relative to the strip length so it looks the same
+// independent of the number of pixels.
+type Aurore struct {
+	Palette   Palette // Colors from the faintest to the brightest part of a band, defaults to "aurora"
+	BandWidth *SValue // Width of a band relative to the strip length, defaults to 0.25 when not set
+	Speed     *SValue // Drift of the bands, in band width per second, defaults to 0.1 when not set
+	Intensity uint8   // Maximum intensity, defaults to 255
+	Seed      int     // Change it to create a different pseudo-random animation
+	t         integral
+}
+
+func (a *Aurore) NextFrame(pixels Frame, timeMS uint32) {
+	palette := a.Palette
+	if len(palette) == 0 {
+		palette = namedPalettes["aurora"]
+	}
+	width := evalOr(a.BandWidth, 0.25, timeMS)
+	if width < 0.001 {
+		// Bands can't be narrower than that.
+		width = 0.001
+	}
+	max := a.Intensity
+	if max == 0 {
+		max = 255
+	}
+	seed := uint32(a.Seed)
+	t := a.t.at(valueOr(a.Speed, 0.1), timeMS)
+	// The whole aurora slowly brightens and dims.
+	breath := 0.5 + 0.5*valueNoise(seed+2, t*0.2)
+	for i := range pixels {
+		u := float64(i) / float64(len(pixels)) / float64(width)
+		// Two layers of noise drifting in opposite directions create bands that
+		// morph over time.
+		n := 0.6*valueNoise(seed, u+t) + 0.4*valueNoise(seed+1, 2.3*u-0.7*t)
+		c := palette.At(n)
+		pixels[i] = Color{}
+		pixels[i].Mix(c, FloatToUint8(float32(max)*n*n*breath))
+	}
+}
+
+// Fire draws flames rising from the start of the strip.
+//
+// The flames are calculated from noise over the position and timeMS, so the
+// animation is deterministic and looks the same independent of the number of
+// pixels.
+type Fire struct {
+	Palette  Palette // Colors from the coldest to the hottest, defaults to "fire"
+	Cooling  *SValue // How fast the flames cool down while rising, 1 means the flames barely reach the end of the strip, defaults to 1.5 when not set
+	Sparking *SValue // Amount of sparks near the base of the flames in [0, 1], defaults to 0.5 when not set, 0 disables sparks
+	Speed    *SValue // Rising speed of the flames, in strip length per second, defaults to 0.5 when not set
+	Seed     int     // Change it to create a different pseudo-random animation
+	t        integral
+}
+
+func (f *Fire) NextFrame(pixels Frame, timeMS uint32) {
+	palette := f.Palette
+	if len(palette) == 0 {
+		palette = namedPalettes["fire"]
+	}
+	cooling := evalOr(f.Cooling, 1.5, timeMS)
+	sparking := evalOr(f.Sparking, 0.5, timeMS)
+	seed := uint32(f.Seed)
+	t := f.t.at(valueOr(f.Speed, 0.5), timeMS)
+	for i := range pixels {
+		x := float32(i) / float32(len(pixels))
+		x64 := float64(x)
+		// The flames are hotter at the base.
+		heat := 1 - cooling*x
+		if heat <= 0 {
+			pixels[i] = palette.At(0)
+			continue
+		}
+		// Turbulence rising over time.
+		n := 0.6*valueNoise(seed, 8*(x64-t)) + 0.4*valueNoise(seed+1, 19*(x64-t))
+		heat *= 0.3 + 0.7*n
+		// Sparks are short and bright bursts near the base.
+		if sparking > 0 {
+			if s := valueNoise(seed+2, 31*(x64-1.5*t)) - (1 - sparking); s > 0 {
+				heat += 0.5 * s / sparking * (1 - 2*x)
+			}
+		}
+		pixels[i] = palette.At(heat)
+	}
+}
+
+// Candle draws a single flickering flame on all the pixels.
+type Candle struct {
+	Palette Palette // Colors from the coldest to the hottest, defaults to "fire"
+	Flicker *SValue // Amplitude of the flickering in [0, 1], defaults to 0.3 when not set
+	Seed    int     // Change it to create a different pseudo-random animation
+}
+
+func (c *Candle) NextFrame(pixels Frame, timeMS uint32) {
+	palette := c.Palette
+	if len(palette) == 0 {
+		palette = namedPalettes["fire"]
+	}
+	flicker := evalOr(c.Flicker, 0.3, timeMS)
+	seed := uint32(c.Seed)
+	t := float64(timeMS) * 0.001
+	// Fast small flickering plus slower occasional gusts of wind.
+	n := 0.7*valueNoise(seed, 12*t) + 0.3*valueNoise(seed+1, 1.3*t)
+	heat := 0.8 - flicker + flicker*n
+	if g := valueNoise(seed+2, 0.5*t); g < 0.15 {
+		heat -= flicker * (0.15 - g) / 0.15
+	}
+	col := palette.At(heat)
+	for i := range pixels {
+		pixels[i] = col
+	}
+}
@@ -5,6 +5,7 @@
 package anim1d
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -54,6 +55,46 @@ func TestColorMix(t *testing.T) {
 	ut.AssertEqual(t, c, a)
 }
 
+func TestColorHSV(t *testing.T) {
+	data := []struct {
+		c       Color
+		h, s, v float32
+		l, sl   float32
+	}{
+		{Color{0, 0, 0}, 0, 0, 0, 0, 0},
+		{Color{255, 255, 255}, 0, 0, 1, 1, 0},
+		{Color{255, 0, 0}, 0, 1, 1, 0.5, 1},
+		{Color{0, 255, 0}, 120, 1, 1, 0.5, 1},
+		{Color{0, 0, 255}, 240, 1, 1, 0.5, 1},
+		{Color{255, 255, 0}, 60, 1, 1, 0.5, 1},
+		{Color{255, 0, 255}, 300, 1, 1, 0.5, 1},
+		{Color{0, 128, 128}, 180, 1, 128. / 255, 64. / 255, 1},
+	}
+	for i, line := range data {
+		h, s, v := line.c.HSV()
+		ut.AssertEqualIndex(t, i, []float32{line.h, line.s, line.v}, []float32{h, s, v})
+		ut.AssertEqualIndex(t, i, line.c, MakeColorHSV(h, s, v))
+		h, s, l := line.c.HSL()
+		ut.AssertEqualIndex(t, i, []float32{line.h, line.sl, line.l}, []float32{h, s, l})
+		ut.AssertEqualIndex(t, i, line.c, MakeColorHSL(h, s, l))
+	}
+	// Hue wraps around.
+	ut.AssertEqual(t, Color{255, 0, 0}, MakeColorHSV(360, 1, 1))
+	ut.AssertEqual(t, Color{255, 0, 255}, MakeColorHSV(-60, 1, 1))
+	ut.AssertEqual(t, Color{0, 255, 0}, MakeColorHSV(480, 1, 1))
+
+	// Every color survives a round trip.
+	for r := 0; r < 256; r += 15 {
+		for g := 0; g < 256; g += 15 {
+			for b := 0; b < 256; b += 15 {
+				c := Color{uint8(r), uint8(g), uint8(b)}
+				ut.AssertEqual(t, c, MakeColorHSV(c.HSV()))
+				ut.AssertEqual(t, c, MakeColorHSL(c.HSL()))
+			}
+		}
+	}
+}
+
 func TestWaveLength2RGB(t *testing.T) {
 	data := []struct {
 		input    int
@@ -475,6 +516,72 @@ func TestWaveLength2RGB(t *testing.T) {
 	}
 }
 
+func TestKelvinToColor(t *testing.T) {
+	ut.AssertEqual(t, Color{255, 255, 255}, kelvinToColor(6600))
+	if c := kelvinToColor(2000); c.R != 255 || c.G >= c.R || c.B >= c.G {
+		t.Fatalf("2000K should be orange: %v", c)
+	}
+	if c := kelvinToColor(15000); c.B != 255 || c.R >= c.B {
+		t.Fatalf("15000K should be blue: %v", c)
+	}
+}
+
+func TestRainbow(t *testing.T) {
+	var f Frame
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`"L6d00c00000ff00adff00ff0c83ff00ffe700ff3e00ff0000b70000000000"`), &f))
+	testFrame(t, &Rainbow{}, expectation{0, f})
+
+	// Scrolling.
+	p := &Rainbow{Speed: SValue{Const(0.5)}}
+	a := make(Frame, 10)
+	b := make(Frame, 10)
+	p.NextFrame(a, 0)
+	ut.AssertEqual(t, f, a)
+	p.NextFrame(b, 2000)
+	ut.AssertEqual(t, a, b)
+	p.NextFrame(b, 500)
+	if a.isEqual(b) {
+		t.Fatal("rainbow didn't scroll")
+	}
+
+	// An animated speed scrolls smoothly, even after a long time.
+	p = &Rainbow{Speed: SValue{&LFO{Min: -2, Max: 2, PeriodMS: 7000}}}
+	for _, timeMS := range []uint32{1 << 24, 1 << 31, 4000000000} {
+		testSmooth(t, p, timeMS)
+	}
+
+	// Span and start.
+	p = &Rainbow{Start: SValue{Const(0.5)}, Span: &SValue{Const(0.01)}}
+	p.NextFrame(a, 0)
+	for i, c := range a {
+		// Yellow.
+		if c.R != 0xFF || c.G < 0xE0 || c.B != 0 {
+			t.Fatalf("%d: unexpected color %v", i, c)
+		}
+	}
+
+	// Saturation and brightness.
+	p = &Rainbow{Saturation: &SValue{Const(0.5)}, Brightness: &SValue{Const(0.5)}}
+	p.NextFrame(a, 0)
+	for i, c := range a[:9] {
+		if c.R < 0x30 || c.G < 0x30 || c.B < 0x30 || c.R > 0x80 || c.G > 0x80 || c.B > 0x80 {
+			t.Fatalf("%d: unexpected color %v", i, c)
+		}
+	}
+
+	// An animated value reaching 0 is 0, not the default value.
+	p = &Rainbow{Brightness: &SValue{&LFO{Min: 0, Max: 1, PeriodMS: 1000}}}
+	p.NextFrame(a, 0)
+	ut.AssertEqual(t, make(Frame, 10), a)
+	p = &Rainbow{Saturation: &SValue{Const(0)}}
+	p.NextFrame(a, 0)
+	for i, c := range a {
+		if c != (Color{0xFF, 0xFF, 0xFF}) {
+			t.Fatalf("%d: unexpected color %v", i, c)
+		}
+	}
+}
+
 func TestRepeated(t *testing.T) {
 	a := Color{0x10, 0x10, 0x10}
 	b := Color{0x20, 0x20, 0x20}
@@ -487,6 +594,368 @@ func TestRepeated(t *testing.T) {
 	testFrames(t, p, e)
 }
 
+func TestWishingStar(t *testing.T) {
+	p := &WishingStar{
+		Color:          Color{255, 255, 255},
+		Length:         5,
+		MovesPerSec:    30,
+		DurationMS:     1000,
+		AverageDelayMS: 2000,
+		Seed:           1,
+	}
+	a := make(Frame, 50)
+	b := make(Frame, 50)
+	lit := 0
+	for timeMS := uint32(0); timeMS < 60000; timeMS += 7 {
+		p.NextFrame(a, timeMS)
+		// Only one star at a time: the lit pixels are contiguous.
+		start, end := -1, -1
+		for i, c := range a {
+			if c != (Color{}) {
+				if start == -1 {
+					start = i
+				}
+				end = i
+			}
+		}
+		if start != -1 {
+			lit++
+			for i := start; i <= end; i++ {
+				if a[i] == (Color{}) {
+					t.Fatalf("%d: more than one star: %s", timeMS, Marshal(a))
+				}
+			}
+			if end-start > p.Length+1 {
+				t.Fatalf("%d: trail is too long: %s", timeMS, Marshal(a))
+			}
+		}
+		// Deterministic: a new instance drawing the same time, after having drawn
+		// another time, returns the same frame.
+		p2 := *p
+		p2.NextFrame(b, timeMS+12345)
+		p2.NextFrame(b, timeMS)
+		ut.AssertEqual(t, a, b)
+	}
+	if lit == 0 {
+		t.Fatal("no star was drawn")
+	}
+
+	// Seed affects the animation.
+	p2 := *p
+	p2.Seed = 2
+	differ := false
+	for timeMS := uint32(0); timeMS < 60000 && !differ; timeMS += 7 {
+		p.NextFrame(a, timeMS)
+		p2.NextFrame(b, timeMS)
+		differ = !a.isEqual(b)
+	}
+	if !differ {
+		t.Fatal("Seed is ignored")
+	}
+}
+
+func TestNightStars(t *testing.T) {
+	p := &NightStars{Seed: 1}
+	a := make(Frame, 100)
+	b := make(Frame, 100)
+	for timeMS := uint32(0); timeMS < 10000; timeMS += 33 {
+		p.NextFrame(a, timeMS)
+		// Deterministic: a new instance drawing the same time, after having drawn
+		// another time, returns the same frame.
+		p2 := &NightStars{Seed: 1}
+		p2.NextFrame(b, timeMS+12345)
+		p2.NextFrame(b, timeMS)
+		ut.AssertEqual(t, a, b)
+	}
+
+	// Look at how each type of star behaves over time.
+	var types [starTypes]int
+	for i := range a {
+		s, ok := p.star(i, 0.3)
+		if !ok {
+			for timeMS := uint32(0); timeMS < 10000; timeMS += 100 {
+				p.NextFrame(a, timeMS)
+				ut.AssertEqual(t, Color{}, a[i])
+			}
+			continue
+		}
+		types[s.Type]++
+		changed := false
+		p.NextFrame(b, 0)
+		for timeMS := uint32(0); timeMS < 10000; timeMS += 100 {
+			p.NextFrame(a, timeMS)
+			changed = changed || a[i] != b[i]
+		}
+		ut.AssertEqual(t, s.Type != StarSteady && s.Intensity > 8, changed)
+	}
+	for i, n := range types {
+		if n == 0 {
+			t.Fatalf("no star of type %d", i)
+		}
+	}
+	if n := types[0] + types[1] + types[2]; n < 15 || n > 45 {
+		t.Fatalf("unexpected density: %d", n)
+	}
+
+	// Intensity caps the intensity.
+	p = &NightStars{Intensity: 10}
+	for timeMS := uint32(0); timeMS < 10000; timeMS += 100 {
+		p.NextFrame(a, timeMS)
+		for _, c := range a {
+			if c.R > 11 || c.G > 11 || c.B > 11 {
+				t.Fatalf("star is too bright: %v", c)
+			}
+		}
+	}
+
+	// A density of 0 shows no star.
+	p = &NightStars{Density: &SValue{Const(0)}}
+	p.NextFrame(a, 1000)
+	ut.AssertEqual(t, make(Frame, len(a)), a)
+}
+
+func TestSupernova(t *testing.T) {
+	p := &Supernova{Color: Color{255, 255, 255}, Size: 4, DurationMS: 2000, AverageDelayMS: 3000}
+	a := make(Frame, 50)
+	b := make(Frame, 50)
+	lit := 0
+	for timeMS := uint32(0); timeMS < 60000; timeMS += 11 {
+		p.NextFrame(a, timeMS)
+		// The glow is symmetric and the center is the brightest.
+		start, end := -1, -1
+		for i, c := range a {
+			if c != (Color{}) {
+				if start == -1 {
+					start = i
+				}
+				end = i
+			}
+		}
+		if start != -1 {
+			lit++
+			if end-start > 2*p.Size+1 {
+				t.Fatalf("%d: glow is too large: %s", timeMS, Marshal(a))
+			}
+			if start != 0 && end != len(a)-1 {
+				center := (start + end) / 2
+				for i := start; i <= end; i++ {
+					if a[i] != a[end-i+start] || a[i].R > a[center].R {
+						t.Fatalf("%d: glow is not centered: %s", timeMS, Marshal(a))
+					}
+				}
+			}
+		}
+		p2 := *p
+		p2.NextFrame(b, timeMS+12345)
+		p2.NextFrame(b, timeMS)
+		ut.AssertEqual(t, a, b)
+	}
+	if lit == 0 {
+		t.Fatal("no supernova was drawn")
+	}
+}
+
+func TestNightSky(t *testing.T) {
+	// Layers are added together.
+	p := &NightSky{
+		Stars:       NightStars{Seed: 2},
+		WishingStar: WishingStar{Color: Color{0, 0, 255}, Length: 5, MovesPerSec: 30, DurationMS: 1000, AverageDelayMS: 1000},
+	}
+	stars := &NightStars{Seed: 2}
+	wishing := p.WishingStar
+	a := make(Frame, 50)
+	b := make(Frame, 50)
+	c := make(Frame, 50)
+	for timeMS := uint32(0); timeMS < 10000; timeMS += 33 {
+		p.NextFrame(a, timeMS)
+		stars.NextFrame(b, timeMS)
+		wishing.NextFrame(c, timeMS)
+		for i := range b {
+			b[i].Add(c[i])
+		}
+		if !frameEqual(a, b) {
+			t.Fatalf("%d: %s != %s", timeMS, Marshal(a), Marshal(b))
+		}
+	}
+
+	// Deterministic with all the layers.
+	p = &NightSky{
+		AuroreIntensity: SValue{Const(0.5)},
+		Stars:           NightStars{Seed: 2},
+		WishingStar:     WishingStar{Color: Color{255, 255, 255}, Length: 5, MovesPerSec: 30, DurationMS: 1000, AverageDelayMS: 1000},
+		Supernova:       Supernova{Color: Color{255, 255, 255}, Size: 3, DurationMS: 2000, AverageDelayMS: 2000},
+	}
+	for timeMS := uint32(0); timeMS < 10000; timeMS += 33 {
+		p.NextFrame(a, timeMS)
+		p2 := &NightSky{}
+		*p2 = *p
+		p2.mixer = Mixer{}
+		p2.NextFrame(b, timeMS+12345)
+		p2.NextFrame(b, timeMS)
+		ut.AssertEqual(t, a, b)
+	}
+}
+
+func TestAurore(t *testing.T) {
+	p := &Aurore{}
+	a := make(Frame, 60)
+	b := make(Frame, 300)
+	lit := false
+	for timeMS := uint32(0); timeMS < 60000; timeMS += 997 {
+		// It looks the same independent of the number of pixels.
+		p.NextFrame(a, timeMS)
+		p.NextFrame(b, timeMS)
+		for i := range a {
+			if !frameEqual(a[i:i+1], b[5*i:5*i+1]) {
+				t.Fatalf("%d: %d: %v != %v", timeMS, i, a[i], b[5*i])
+			}
+			lit = lit || a[i] != Color{}
+		}
+	}
+	if !lit {
+		t.Fatal("nothing was drawn")
+	}
+
+	// The parameters are used.
+	p = &Aurore{Palette: Palette{{Color{255, 0, 0}, 0}}, Intensity: 16}
+	for timeMS := uint32(0); timeMS < 60000; timeMS += 997 {
+		p.NextFrame(a, timeMS)
+		for _, c := range a {
+			if c.R > 17 || c.G != 0 || c.B != 0 {
+				t.Fatalf("%d: unexpected color %v", timeMS, c)
+			}
+		}
+	}
+
+	// It doesn't jump after running for a long time.
+	p = &Aurore{Speed: &SValue{Const(10)}}
+	for _, timeMS := range []uint32{1 << 24, 1 << 31, 4000000000} {
+		testSmooth(t, p, timeMS)
+	}
+}
+
+func TestFire(t *testing.T) {
+	p := &Fire{}
+	a := make(Frame, 60)
+	b := make(Frame, 300)
+	var base, top int
+	for timeMS := uint32(0); timeMS < 30000; timeMS += 331 {
+		p.NextFrame(a, timeMS)
+		p.NextFrame(b, timeMS)
+		for i := range a {
+			if !frameEqual(a[i:i+1], b[5*i:5*i+1]) {
+				t.Fatalf("%d: %d: %v != %v", timeMS, i, a[i], b[5*i])
+			}
+		}
+		for i := 0; i < 10; i++ {
+			base += int(a[i].R) + int(a[i].G) + int(a[i].B)
+			top += int(a[len(a)-i-1].R) + int(a[len(a)-i-1].G) + int(a[len(a)-i-1].B)
+		}
+	}
+	if base <= top || top != 0 {
+		t.Fatalf("flames should rise from the base: %d vs %d", base, top)
+	}
+
+	// Deterministic.
+	c := make(Frame, 60)
+	for timeMS := uint32(0); timeMS < 30000; timeMS += 331 {
+		p.NextFrame(a, timeMS)
+		p2 := &Fire{}
+		p2.NextFrame(c, timeMS+12345)
+		p2.NextFrame(c, timeMS)
+		ut.AssertEqual(t, a, c)
+	}
+
+	// It doesn't jump after running for a long time.
+	for _, timeMS := range []uint32{1 << 24, 1 << 31, 4000000000} {
+		testSmooth(t, p, timeMS)
+	}
+}
+
+func TestCandle(t *testing.T) {
+	p := &Candle{}
+	a := make(Frame, 3)
+	seen := map[Color]bool{}
+	for timeMS := uint32(0); timeMS < 30000; timeMS += 33 {
+		p.NextFrame(a, timeMS)
+		ut.AssertEqual(t, a[0], a[1])
+		ut.AssertEqual(t, a[0], a[2])
+		if a[0].R == 0 {
+			t.Fatalf("%d: the candle is off", timeMS)
+		}
+		seen[a[0]] = true
+	}
+	if len(seen) < 10 {
+		t.Fatalf("the candle doesn't flicker: %d", len(seen))
+	}
+	for _, timeMS := range []uint32{1 << 24, 1 << 31, 4000000000} {
+		testSmooth(t, p, timeMS)
+	}
+
+	// Without flickering, the flame is steady.
+	q := &Candle{Flicker: &SValue{Const(0)}}
+	q.NextFrame(a, 0)
+	b := make(Frame, 3)
+	q.NextFrame(b, 1234)
+	ut.AssertEqual(t, a, b)
+}
+
+func TestPalette(t *testing.T) {
+	red := Color{0xFF, 0x00, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	p := makePalette(Frame{red, blue})
+	ut.AssertEqual(t, red, p.At(-1))
+	ut.AssertEqual(t, red, p.At(0))
+	ut.AssertEqual(t, Color{0x7F, 0x00, 0x80}, p.At(0.5))
+	ut.AssertEqual(t, blue, p.At(1))
+	ut.AssertEqual(t, blue, makePalette(Frame{red, red, blue}).At(1))
+	ut.AssertEqual(t, red, makePalette(Frame{red}).At(0.5))
+	ut.AssertEqual(t, Color{}, Palette{}.At(0.5))
+
+	// Positioned stops with a hard edge.
+	p = Palette{{red, 0.2}, {red, 0.5}, {blue, 0.5}, {blue, 0.8}}
+	ut.AssertEqual(t, red, p.At(0))
+	ut.AssertEqual(t, red, p.At(0.49))
+	ut.AssertEqual(t, blue, p.At(0.5))
+	ut.AssertEqual(t, blue, p.At(1))
+	for _, name := range []string{"aurora", "fire", "forest", "lava", "ocean", "rainbow"} {
+		if len(namedPalettes[name]) < 2 {
+			t.Fatalf("missing palette %q", name)
+		}
+	}
+}
+
+func TestNoise(t *testing.T) {
+	p := &Noise{Speed: SValue{Const(1)}}
+	a := make(Frame, 60)
+	p.NextFrame(a, 1000)
+	seen := map[Color]bool{}
+	for _, c := range a {
+		ut.AssertEqual(t, c.R, c.G)
+		ut.AssertEqual(t, c.R, c.B)
+		seen[c] = true
+	}
+	if len(seen) < 10 {
+		t.Fatalf("noise is too flat: %s", Marshal(a))
+	}
+	b := make(Frame, 60)
+	p.NextFrame(b, 2000)
+	if a.isEqual(b) {
+		t.Fatal("noise doesn't evolve")
+	}
+	// It doesn't jump after running for a long time.
+	for _, timeMS := range []uint32{1 << 24, 1 << 31, 4000000000} {
+		testSmooth(t, p, timeMS)
+	}
+
+	// A scale of 0 is uniform.
+	q := &Noise{Scale: &SValue{Const(0)}}
+	q.NextFrame(a, 1000)
+	for i := range a {
+		ut.AssertEqual(t, a[0], a[i])
+	}
+}
+
 //
 
 type expectation struct {
@@ -514,6 +983,22 @@ func testFrame(t *testing.T, p Pattern, e expectation) {
 	}
 }
 
+// testSmooth asserts that the pattern doesn't jump between timeMS-1 and
+// timeMS.
+func testSmooth(t *testing.T, p Pattern, timeMS uint32) {
+	a := make(Frame, 60)
+	b := make(Frame, 60)
+	p.NextFrame(a, timeMS-1)
+	p.NextFrame(b, timeMS)
+	for i := range a {
+		for _, d := range []int{int(a[i].R) - int(b[i].R), int(a[i].G) - int(b[i].G), int(a[i].B) - int(b[i].B)} {
+			if d > 24 || d < -24 {
+				t.Fatalf("%d: %d: jumped from %v to %v", timeMS, i, a[i], b[i])
+			}
+		}
+	}
+}
+
 func frameEqual(lhs, rhs Frame) bool {
 	for i, a := range lhs {
 		b := rhs[i]
@@ -13,13 +13,18 @@ import (
 	"image/png"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // List all known patterns and mixers that can be instantiated.
 var serializerLookup map[string]reflect.Type
 
-const rainbowKey = "Rainbow"
+const (
+	rainbowKey = "Rainbow"
+	hsvKey     = "hsv"
+	hslKey     = "hsl"
+)
 
 var knownPatterns = []Pattern{
 	// Patterns
@@ -30,25 +35,72 @@ var knownPatterns = []Pattern{
 	&NightSky{},
 	&Aurore{},
 	&NightStars{},
+	&NightStar{},
 	&WishingStar{},
+	&Supernova{},
+	&Fire{},
+	&Candle{},
+	&Noise{},
+	&Particles{},
+	&VUMeter{},
+	&Spectrum{},
+	&Morse{},
+	&Text{},
+	&Clock{},
 	// Mixers
 	&Gradient{},
+	&MultiGradient{},
 	&Transition{},
+	&Timeline{},
 	&Cycle{},
 	&Loop{},
 	&Rotate{},
 	&PingPong{},
+	&Mirror{},
+	&Reverse{},
+	&Tile{},
+	&Kaleidoscope{},
 	&Crop{},
+	&Segments{},
+	&Bar{},
+	&Threshold{},
 	&Mixer{},
+	&Layers{},
 	&Scale{},
+	&PaletteMap{},
+	&HueShift{},
+	&Dim{},
+	&Gamma{},
+	&Tint{},
+	&Kelvin{},
+	&Invert{},
+	&Saturate{},
+}
+
+// knownValues lists the animated values that can be instantiated. Const is
+// not listed as it is serialized as a plain number.
+var knownValues = []Value{
+	&Ramp{},
+	&LFO{},
+	&Keyframes{},
+	&Wander{},
+	&AudioLevel{},
+	&Variable{},
 }
 
+var valueLookup map[string]reflect.Type
+
 func init() {
 	serializerLookup = make(map[string]reflect.Type, len(knownPatterns))
 	for _, i := range knownPatterns {
 		r := reflect.TypeOf(i).Elem()
 		serializerLookup[r.Name()] = r
 	}
+	valueLookup = make(map[string]reflect.Type, len(knownValues))
+	for _, i := range knownValues {
+		r := reflect.TypeOf(i).Elem()
+		valueLookup[r.Name()] = r
+	}
 }
 
 // SPattern is a Pattern that can be serialized.
@@ -58,6 +110,43 @@ type SPattern struct {
 	Pattern
 }
 
+// SValue is a Value that can be serialized.
+//
+// It is used for numeric parameters so they can be animated. A nil Value
+// evaluates to 0.
+//
+// Some numeric fields are intentionally kept as plain numbers:
+//   - Durations like Transition.DurationMS, Loop.DurationShowMS or PerMoveMS define
+//     the timeline itself and must stay exact; use MovesPerSec or Mixer
+//     weights to animate the speed instead.
+//   - Pixel counts like Scale.Length and Tile.Length select pixels, they are
+//     not continuous quantities.
+//   - Seeds, enums, and positions inside lists like Palette or Keyframes.
+//   - uint8 intensities and temperatures; wrap the pattern in Dim or Kelvin
+//     to animate them.
+//   - Parameters of the Value types themselves and the Bar and Threshold
+//     bounds; animate the Value they read instead.
+//   - WishingStar, Supernova and Particles settings, since each event is
+//     computed analytically from its start time.
+//
+// Colors are not numbers. Filters taking a color, like Tint, take an SPattern
+// instead so a Transition or a Timeline between colors animates them.
+type SValue struct {
+	Value
+}
+
+func (s SValue) Eval(timeMS uint32) float32 {
+	if s.Value == nil {
+		return 0
+	}
+	return s.Value.Eval(timeMS)
+}
+
+// isZero returns true if the value is not set or is the constant 0.
+func (s SValue) isZero() bool {
+	return s.Value == nil || s.Value == Const(0)
+}
+
 // jsonUnmarshalDict unmarshals data into a map of interface{} without mangling
 // int64.
 func jsonUnmarshalDict(b []byte) (map[string]interface{}, error) {
@@ -74,7 +163,11 @@ func jsonUnmarshalString(b []byte) (string, error) {
 	return s, err
 }
 
-// UnmarshalJSON decodes the string "#RRGGBB" to the color.
+// UnmarshalJSON decodes the string "#RRGGBB", "hsv(h,s,v)" or "hsl(h,s,l)"
+// to the color.
+//
+// The hue is in degrees. The other components are either in [0, 1] or
+// expressed as a percentage like "50%".
 //
 // If unmarshalling fails, 'c' is not touched.
 func (c *Color) UnmarshalJSON(b []byte) error {
@@ -82,10 +175,17 @@ func (c *Color) UnmarshalJSON(b []byte) error {
 	if err != nil {
 		return err
 	}
-	if len(s) == 0 || s[0] != '#' {
-		return errors.New("invalid color string")
+	var c2 Color
+	switch {
+	case len(s) != 0 && s[0] == '#':
+		c2, err = stringToColor(s[1:])
+	case strings.HasPrefix(s, hsvKey+"("):
+		c2, err = stringToColorFunc(s, hsvKey, MakeColorHSV)
+	case strings.HasPrefix(s, hslKey+"("):
+		c2, err = stringToColorFunc(s, hslKey, MakeColorHSL)
+	default:
+		err = errors.New("invalid color string")
 	}
-	c2, err := stringToColor(s[1:])
 	if err == nil {
 		*c = c2
 	}
@@ -131,21 +231,160 @@ func (f Frame) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out.String())
 }
 
-// UnmarshalJSON decodes the string "Rainbow" to the rainbow.
+// rainbowAlias is used to serialize Rainbow as a dict without recursing.
+type rainbowAlias Rainbow
+
+// UnmarshalJSON decodes the string "Rainbow" or a dict to the rainbow.
+//
+// If unmarshalling fails, 'r' is not touched.
 func (r *Rainbow) UnmarshalJSON(b []byte) error {
+	if s, err := jsonUnmarshalString(b); err == nil {
+		if s != rainbowKey {
+			return errors.New("invalid color string")
+		}
+		*r = Rainbow{}
+		return nil
+	}
+	var r2 rainbowAlias
+	if err := json.Unmarshal(b, &r2); err != nil {
+		return err
+	}
+	*r = Rainbow(r2)
+	return nil
+}
+
+// MarshalJSON encodes the rainbow as a string "Rainbow" when using the
+// default values, as a dict otherwise.
+func (r *Rainbow) MarshalJSON() ([]byte, error) {
+	if r.Start.isZero() && r.Span == nil && r.Speed.isZero() && r.Saturation == nil && r.Brightness == nil {
+		return json.Marshal(rainbowKey)
+	}
+	return json.Marshal((*rainbowAlias)(r))
+}
+
+// UnmarshalJSON decodes a palette either as the name of a built-in palette,
+// as a Frame string "LRRGGBB..." of evenly spaced colors or as a list of
+// stops.
+//
+// If unmarshalling fails, 'p' is not touched.
+func (p *Palette) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*p = nil
+		return nil
+	}
+	if s, err := jsonUnmarshalString(b); err == nil {
+		if len(s) != 0 && s[0] == 'L' {
+			var f Frame
+			if err := json.Unmarshal(b, &f); err != nil {
+				return err
+			}
+			*p = makePalette(f)
+			return nil
+		}
+		p2, ok := namedPalettes[s]
+		if !ok {
+			return errors.New("unknown palette")
+		}
+		*p = append(Palette(nil), p2...)
+		return nil
+	}
+	// Use an alias type to not recurse into this function.
+	var p2 []PaletteStop
+	if err := json.Unmarshal(b, &p2); err != nil {
+		return err
+	}
+	*p = p2
+	return nil
+}
+
+// legacyTransitions maps the names written by older versions, which may still
+// be in saved configurations, to their current value.
+var legacyTransitions = map[string]TransitionType{
+	"easeinout": TransitionEaseInOut,
+}
+
+// UnmarshalJSON decodes the transition and rejects unknown names and
+// malformed cubic-bezier() and steps() values.
+//
+// If unmarshalling fails, 't' is not touched.
+func (t *TransitionType) UnmarshalJSON(b []byte) error {
 	s, err := jsonUnmarshalString(b)
 	if err != nil {
 		return err
 	}
-	if s != rainbowKey {
-		return errors.New("invalid color string")
+	t2 := TransitionType(s)
+	if l, ok := legacyTransitions[s]; ok {
+		t2 = l
 	}
-	return err
+	if err := t2.validate(); err != nil {
+		return err
+	}
+	*t = t2
+	return nil
 }
 
-// MarshalJSON encodes the rainbow as a string "Rainbow".
-func (r *Rainbow) MarshalJSON() ([]byte, error) {
-	return json.Marshal(rainbowKey)
+// UnmarshalJSON decodes the string to a BlendMode.
+//
+// It refuses unknown blend modes.
+func (b *BlendMode) UnmarshalJSON(d []byte) error {
+	s, err := jsonUnmarshalString(d)
+	if err != nil {
+		return err
+	}
+	b2 := BlendMode(s)
+	if err := b2.validate(); err != nil {
+		return err
+	}
+	*b = b2
+	return nil
+}
+
+// UnmarshalJSON decodes the string to a CycleMode.
+//
+// It refuses unknown modes.
+func (c *CycleMode) UnmarshalJSON(d []byte) error {
+	s, err := jsonUnmarshalString(d)
+	if err != nil {
+		return err
+	}
+	c2 := CycleMode(s)
+	if err := c2.validate(); err != nil {
+		return err
+	}
+	*c = c2
+	return nil
+}
+
+// UnmarshalJSON decodes the string to a TransitionStyle.
+//
+// It refuses unknown styles.
+func (t *TransitionStyle) UnmarshalJSON(d []byte) error {
+	s, err := jsonUnmarshalString(d)
+	if err != nil {
+		return err
+	}
+	t2 := TransitionStyle(s)
+	if err := t2.validate(); err != nil {
+		return err
+	}
+	*t = t2
+	return nil
+}
+
+// UnmarshalJSON decodes the string to a ClockLayout.
+//
+// It refuses unknown layouts.
+func (c *ClockLayout) UnmarshalJSON(d []byte) error {
+	s, err := jsonUnmarshalString(d)
+	if err != nil {
+		return err
+	}
+	c2 := ClockLayout(s)
+	if err := c2.validate(); err != nil {
+		return err
+	}
+	*c = c2
+	return nil
 }
 
 // UnmarshalJSON decodes a Pattern.
@@ -207,6 +446,68 @@ func (p *SPattern) MarshalJSON() ([]byte, error) {
 	return json.Marshal(tmp)
 }
 
+// UnmarshalJSON decodes a Value.
+//
+// A plain number is decoded as a Const, so parameters that used to be numbers
+// stay compatible.
+//
+// If unmarshalling fails, 's' is not touched.
+func (s *SValue) UnmarshalJSON(b []byte) error {
+	var f float32
+	if err := json.Unmarshal(b, &f); err == nil {
+		s.Value = Const(f)
+		return nil
+	}
+	tmp, err := jsonUnmarshalDict(b)
+	if err != nil {
+		return err
+	}
+	if len(tmp) == 0 {
+		s.Value = nil
+		return nil
+	}
+	n, ok := tmp["_type"]
+	if !ok {
+		return errors.New("missing value type")
+	}
+	name, ok := n.(string)
+	if !ok {
+		return errors.New("invalid value type")
+	}
+	t, ok := valueLookup[name]
+	if !ok {
+		return errors.New("value type not found")
+	}
+	v := reflect.New(t).Interface()
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	s.Value = v.(Value)
+	return nil
+}
+
+// MarshalJSON encodes a Value.
+//
+// A nil Value or a Const is encoded as a plain number.
+func (s SValue) MarshalJSON() ([]byte, error) {
+	switch v := s.Value.(type) {
+	case nil:
+		return []byte("0"), nil
+	case Const:
+		return json.Marshal(float32(v))
+	}
+	b, err := json.Marshal(s.Value)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := jsonUnmarshalDict(b)
+	if err != nil {
+		return nil, err
+	}
+	tmp["_type"] = reflect.TypeOf(s.Value).Elem().Name()
+	return json.Marshal(tmp)
+}
+
 // parseString returns a Pattern object out of the serialized JSON string.
 func parseString(b []byte) (Pattern, error) {
 	s, err := jsonUnmarshalString(b)
@@ -215,8 +516,8 @@ func parseString(b []byte) (Pattern, error) {
 	}
 	if len(s) != 0 {
 		switch s[0] {
-		case '#':
-			// "#RRGGBB"
+		case '#', hsvKey[0]:
+			// "#RRGGBB", "hsv(h,s,v)" or "hsl(h,s,l)"
 			c := &Color{}
 			err := json.Unmarshal(b, c)
 			return c, err
@@ -284,9 +585,37 @@ func stringToColor(s string) (Color, error) {
 	return c, nil
 }
 
+// stringToColorFunc converts a "name(h,a,b)" encoded string to a Color with
+// the conversion function f.
+func stringToColorFunc(s, name string, f func(h, a, b float32) Color) (Color, error) {
+	args, err := parseFunc(s, name)
+	if err != nil {
+		return Color{}, err
+	}
+	if len(args) != 3 {
+		return Color{}, fmt.Errorf("%s() requires 3 arguments", name)
+	}
+	var v [3]float32
+	for i, a := range args {
+		scale := float32(1)
+		if i != 0 && strings.HasSuffix(a, "%") {
+			a = a[:len(a)-1]
+			scale = 0.01
+		}
+		x, err := strconv.ParseFloat(a, 32)
+		if err != nil {
+			return Color{}, err
+		}
+		v[i] = float32(x) * scale
+	}
+	return f(v[0], v[1], v[2]), nil
+}
+
 // LoadPNG loads a PNG file and creates a Cycle out of the lines.
 //
-// If vertical is true, rotate the image by 90°.
+// If vertical is true, rotate the image by 90°. The Cycle loops by default;
+// set Mode to CycleOnce to stop on the last line and Transition to
+// TransitionLinear to blend between lines.
 func LoadPNG(content []byte, frameDuration time.Duration, vertical bool) *Cycle {
 	img, err := png.Decode(bytes.NewReader(content))
 	if err != nil {
@@ -318,5 +647,5 @@ func LoadPNG(content []byte, frameDuration time.Duration, vertical bool) *Cycle
 	for i, p := range buf {
 		children[i].Pattern = p
 	}
-	return &Cycle{children, uint32(frameDuration / time.Millisecond)}
+	return &Cycle{Frames: children, FrameDurationMS: uint32(frameDuration / time.Millisecond)}
 }
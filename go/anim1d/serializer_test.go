@@ -58,8 +58,13 @@ func TestJSON(t *testing.T) {
 	serialize(t, &Frame{}, `"L"`)
 	serialize(t, &Frame{{1, 2, 3}, {4, 5, 6}}, `"L010203040506"`)
 	serialize(t, &Rainbow{}, `"Rainbow"`)
-	serialize(t, &PingPong{}, `{"Child":{},"MovesPerSec":0,"_type":"PingPong"}`)
-	serialize(t, &Cycle{}, `{"FrameDurationMS":0,"Frames":null,"_type":"Cycle"}`)
+	serialize(t, &Rainbow{Speed: SValue{Const(0.1)}}, `{"Brightness":null,"Saturation":null,"Span":null,"Speed":0.1,"Start":0,"_type":"Rainbow"}`)
+	serialize(t, &PingPong{}, `{"Child":{},"MovesPerSec":0,"PerMoveMS":0,"Transition":"","_type":"PingPong"}`)
+	serialize(t, &NightStar{Intensity: 255, Type: StarPulse, Temperature: 6500, Seed: 3}, `{"Intensity":255,"Seed":3,"Temperature":6500,"Type":1,"_type":"NightStar"}`)
+	serialize(t, &Cycle{}, `{"DurationsMS":null,"FrameDurationMS":0,"Frames":null,"Mode":"","Transition":"","_type":"Cycle"}`)
+	serialize(t, &Gradient{Transition: TransitionCubicBezier(0.1, 0.2, 0.3, 0.4)}, `{"Left":{},"Right":{},"Transition":"cubic-bezier(0.1,0.2,0.3,0.4)","_type":"Gradient"}`)
+	serialize(t, &MultiGradient{Stops: []GradientStop{{SPattern{&Color{1, 2, 3}}, 0.5, TransitionLinear}}}, `{"Stops":[{"Pattern":"#010203","Position":0.5,"Transition":"linear"}],"_type":"MultiGradient"}`)
+	serialize(t, &Gradient{Transition: TransitionSteps(5, "start")}, `{"Left":{},"Right":{},"Transition":"steps(5,start)","_type":"Gradient"}`)
 
 	// Create one more complex. Assert that int64 is not mangled.
 	p := &Transition{
@@ -76,6 +81,142 @@ func TestJSON(t *testing.T) {
 		DurationMS: 600000,
 		Transition: TransitionLinear,
 	}
-	expected := `{"After":"#000000","Before":{"After":"#ffffff","Before":{},"DurationMS":600000,"OffsetMS":600000,"Transition":"linear","_type":"Transition"},"DurationMS":600000,"OffsetMS":1800000,"Transition":"linear","_type":"Transition"}`
+	expected := `{"After":"#000000","Before":{"After":"#ffffff","Before":{},"DurationMS":600000,"OffsetMS":600000,"Style":"","Transition":"linear","_type":"Transition"},"DurationMS":600000,"OffsetMS":1800000,"Style":"","Transition":"linear","_type":"Transition"}`
 	serialize(t, p, expected)
 }
+
+func TestJSONColor(t *testing.T) {
+	data := []struct {
+		s        string
+		expected Color
+	}{
+		{`"#102030"`, Color{0x10, 0x20, 0x30}},
+		{`"hsv(120,1,1)"`, Color{0, 255, 0}},
+		{`"hsv(240, 100%, 50%)"`, Color{0, 0, 128}},
+		{`"hsl(0,1,0.5)"`, Color{255, 0, 0}},
+		{`"hsl(60, 100%, 100%)"`, Color{255, 255, 255}},
+	}
+	for i, line := range data {
+		var c Color
+		ut.AssertEqualIndex(t, i, nil, json.Unmarshal([]byte(line.s), &c))
+		ut.AssertEqualIndex(t, i, line.expected, c)
+		var p SPattern
+		ut.AssertEqualIndex(t, i, nil, json.Unmarshal([]byte(line.s), &p))
+		ut.AssertEqualIndex(t, i, &line.expected, p.Pattern)
+	}
+	for _, s := range []string{`""`, `"#12"`, `"hsv(1,2)"`, `"hsv(a,1,1)"`, `"hsl(1,1,1"`, `"rgb(1,1,1)"`} {
+		var c Color
+		if json.Unmarshal([]byte(s), &c) == nil {
+			t.Fatalf("%s should fail", s)
+		}
+	}
+}
+
+func TestJSONPalette(t *testing.T) {
+	var p Palette
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`"ocean"`), &p))
+	ut.AssertEqual(t, namedPalettes["ocean"], p)
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`"Lff00000000ff"`), &p))
+	ut.AssertEqual(t, Palette{{Color{255, 0, 0}, 0}, {Color{0, 0, 255}, 1}}, p)
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`[{"Color":"#010203","Position":0.5}]`), &p))
+	ut.AssertEqual(t, Palette{{Color{1, 2, 3}, 0.5}}, p)
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`null`), &p))
+	ut.AssertEqual(t, Palette(nil), p)
+	if json.Unmarshal([]byte(`"foo"`), &p) == nil {
+		t.Fatal("expected error")
+	}
+	serialize(t, &PaletteMap{Palette: Palette{{Color{1, 2, 3}, 0.5}}}, `{"Child":{},"Palette":[{"Color":"#010203","Position":0.5}],"_type":"PaletteMap"}`)
+
+	var s SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Child":{"_type":"Noise"},"Palette":"lava","_type":"PaletteMap"}`), &s))
+	ut.AssertEqual(t, namedPalettes["lava"], s.Pattern.(*PaletteMap).Palette)
+}
+
+func TestJSONTransitionType(t *testing.T) {
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Transition":"steps(3, end)","_type":"Gradient"}`), &p))
+	ut.AssertEqual(t, TransitionType("steps(3, end)"), p.Pattern.(*Gradient).Transition)
+	// It was parsed once while unmarshalling.
+	transitionsLock.RLock()
+	c := transitions["steps(3, end)"]
+	transitionsLock.RUnlock()
+	ut.AssertEqual(t, transitionCurve{steps: 3, pos: stepEnd}, c)
+	if json.Unmarshal([]byte(`{"Transition":"steps(0,end)","_type":"Gradient"}`), &p) == nil {
+		t.Fatal("expected error")
+	}
+	// Legacy name saved by older versions.
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Transition":"easeinout","_type":"Gradient"}`), &p))
+	ut.AssertEqual(t, TransitionEaseInOut, p.Pattern.(*Gradient).Transition)
+	if json.Unmarshal([]byte(`{"Transition":"easeout","_type":"Gradient"}`), &p) == nil {
+		t.Fatal("expected error")
+	}
+	if json.Unmarshal([]byte(`{"Transition":"cubic-bezier(2,0,1,1)","_type":"Loop"}`), &p) == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestJSONBlendMode(t *testing.T) {
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Layers":[{"Pattern":"#ff0000","Blend":"screen"}],"_type":"Layers"}`), &p))
+	l := p.Pattern.(*Layers)
+	ut.AssertEqual(t, BlendScreen, l.Layers[0].Blend)
+	ut.AssertEqual(t, &Color{0xFF, 0x00, 0x00}, l.Layers[0].Pattern.Pattern)
+	if json.Unmarshal([]byte(`{"Layers":[{"Blend":"overlay"}],"_type":"Layers"}`), &p) == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	// Plain numbers stay compatible.
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"MovesPerSec":30,"_type":"PingPong"}`), &p))
+	ut.AssertEqual(t, SValue{Const(30)}, p.Pattern.(*PingPong).MovesPerSec)
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Patterns":["#ff0000"],"Weights":[0.5],"_type":"Mixer"}`), &p))
+	ut.AssertEqual(t, []SValue{{Const(0.5)}}, p.Pattern.(*Mixer).Weights)
+	serialize(t, &Mixer{Weights: []SValue{{Const(0.5)}, {}}}, `{"Patterns":null,"Weights":[0.5,0],"_type":"Mixer"}`)
+
+	r := &Rotate{MovesPerSec: SValue{&Ramp{From: 1, To: 10, DurationMS: 1000}}}
+	serialize(t, r, `{"Child":{},"MovesPerSec":{"DurationMS":1000,"From":1,"OffsetMS":0,"To":10,"Transition":"","_type":"Ramp"},"PerMoveMS":0,"Transition":"","_type":"Rotate"}`)
+	for _, v := range knownValues {
+		b, err := json.Marshal(SValue{v})
+		ut.AssertEqual(t, nil, err)
+		var s SValue
+		ut.AssertEqualf(t, nil, json.Unmarshal(b, &s), "%s", b)
+		ut.AssertEqual(t, v, s.Value)
+	}
+	var s SValue
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{}`), &s))
+	ut.AssertEqual(t, SValue{}, s)
+	for _, v := range []string{`"1"`, `{"From":1}`, `{"_type":"Foo"}`, `{"_type":1}`} {
+		if json.Unmarshal([]byte(v), &s) == nil {
+			t.Fatalf("%s should fail", v)
+		}
+	}
+}
+
+func TestJSONCycleMode(t *testing.T) {
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Mode":"pingpong","_type":"Cycle"}`), &p))
+	ut.AssertEqual(t, CyclePingPong, p.Pattern.(*Cycle).Mode)
+	if json.Unmarshal([]byte(`{"Mode":"bounce","_type":"Cycle"}`), &p) == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestJSONTransitionStyle(t *testing.T) {
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Style":"wipecenter","_type":"Loop"}`), &p))
+	ut.AssertEqual(t, StyleWipeCenter, p.Pattern.(*Loop).Style)
+	if json.Unmarshal([]byte(`{"Style":"spiral","_type":"Transition"}`), &p) == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestJSONClockLayout(t *testing.T) {
+	var p SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Layout":"arcs","_type":"Clock"}`), &p))
+	ut.AssertEqual(t, ClockArcs, p.Pattern.(*Clock).Layout)
+	if json.Unmarshal([]byte(`{"Layout":"hands","_type":"Clock"}`), &p) == nil {
+		t.Fatal("expected error")
+	}
+}
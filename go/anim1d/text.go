@@ -0,0 +1,304 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import "strings"
+
+// Morse flashes Text in Morse code on the whole strip.
+//
+// Letters, digits and common punctuation are supported; other characters are
+// skipped. The message repeats after a word gap.
+type Morse struct {
+	Text   string
+	Child  SPattern // Pattern shown while the signal is on, defaults to white
+	UnitMS uint32   // Duration of a dot, defaults to 100
+	text   string
+	units  []uint32
+	total  uint32
+}
+
+func (m *Morse) NextFrame(pixels Frame, timeMS uint32) {
+	if m.units == nil || m.text != m.Text {
+		m.text = m.Text
+		m.units = morseUnits(m.Text)
+		m.total = 0
+		for _, u := range m.units {
+			m.total += u
+		}
+	}
+	on := false
+	if m.total != 0 {
+		unit := m.UnitMS
+		if unit == 0 {
+			unit = 100
+		}
+		t := (timeMS / unit) % m.total
+		i := 0
+		for ; t >= m.units[i]; i++ {
+			t -= m.units[i]
+		}
+		on = i&1 == 0
+	}
+	if !on {
+		for i := range pixels {
+			pixels[i] = Color{}
+		}
+	} else if m.Child.Pattern != nil {
+		m.Child.NextFrame(pixels, timeMS)
+	} else {
+		for i := range pixels {
+			pixels[i] = Color{0xFF, 0xFF, 0xFF}
+		}
+	}
+}
+
+// Text shows a message with a 5x7 bitmap font one column at a time, for
+// persistence of vision. The strip is meant to be waved sideways.
+//
+// The glyphs are stretched over the whole strip, pixel 0 being the bottom
+// of the glyphs. Characters outside of printable ASCII are shown as '?'.
+type Text struct {
+	Text       string
+	Color      Color  // Defaults to white
+	Background Color  // Color around the glyphs
+	ColumnMS   uint32 // Duration of each column, defaults to 2
+	GapMS      uint32 // Duration of the background shown before the message repeats
+	cycle      *Cycle
+	key        textKey
+}
+
+func (t *Text) NextFrame(pixels Frame, timeMS uint32) {
+	k := textKey{t.Text, t.Color, t.Background, t.ColumnMS, t.GapMS, len(pixels)}
+	if t.cycle == nil || t.key != k {
+		t.key = k
+		t.cycle = textCycle(k)
+	}
+	t.cycle.NextFrame(pixels, timeMS)
+}
+
+// Private details.
+
+// morseCode maps a character to its dots and dashes.
+var morseCode = map[rune]string{
+	'A': ".-", 'B': "-...", 'C': "-.-.", 'D': "-..", 'E': ".", 'F': "..-.",
+	'G': "--.", 'H': "....", 'I': "..", 'J': ".---", 'K': "-.-", 'L': ".-..",
+	'M': "--", 'N': "-.", 'O': "---", 'P': ".--.", 'Q': "--.-", 'R': ".-.",
+	'S': "...", 'T': "-", 'U': "..-", 'V': "...-", 'W': ".--", 'X': "-..-",
+	'Y': "-.--", 'Z': "--..",
+	'0': "-----", '1': ".----", '2': "..---", '3': "...--", '4': "....-",
+	'5': ".....", '6': "-....", '7': "--...", '8': "---..", '9': "----.",
+	'.': ".-.-.-", ',': "--..--", '?': "..--..", '\'': ".----.", '!': "-.-.--",
+	'/': "-..-.", '(': "-.--.", ')': "-.--.-", '&': ".-...", ':': "---...",
+	';': "-.-.-.", '=': "-...-", '+': ".-.-.", '-': "-....-", '"': ".-..-.",
+	'@': ".--.-.",
+}
+
+// morseUnits returns the alternating on and off durations, in units, to send
+// text. It always starts with an on duration and ends with a word gap.
+func morseUnits(text string) []uint32 {
+	var out []uint32
+	word := false
+	for _, r := range strings.ToUpper(text) {
+		if r == ' ' {
+			word = true
+			continue
+		}
+		code, ok := morseCode[r]
+		if !ok {
+			continue
+		}
+		for i, e := range code {
+			if len(out) != 0 {
+				// Gap between elements, letters and words.
+				g := uint32(1)
+				if i == 0 {
+					g = 3
+					if word {
+						g = 7
+					}
+				}
+				out = append(out, g)
+			}
+			if e == '-' {
+				out = append(out, 3)
+			} else {
+				out = append(out, 1)
+			}
+		}
+		word = false
+	}
+	if len(out) != 0 {
+		out = append(out, 7)
+	}
+	return out
+}
+
+// textKey is everything a Text Cycle depends on.
+type textKey struct {
+	text       string
+	color      Color
+	background Color
+	columnMS   uint32
+	gapMS      uint32
+	length     int
+}
+
+// textCycle rasterizes the text into one Frame per column.
+func textCycle(k textKey) *Cycle {
+	c := k.color
+	if c == (Color{}) {
+		c = Color{0xFF, 0xFF, 0xFF}
+	}
+	column := k.columnMS
+	if column == 0 {
+		column = 2
+	}
+	// Precalculate the font row shown by each pixel.
+	rows := make([]uint8, k.length)
+	for i := range rows {
+		rows[i] = uint8(fontHeight - 1 - i*fontHeight/k.length)
+	}
+	var frames []SPattern
+	for _, r := range k.text {
+		if r < ' ' || r > '~' {
+			r = '?'
+		}
+		glyph := font5x7[(r-' ')*fontWidth : (r-' '+1)*fontWidth]
+		// Add an empty column after each glyph.
+		for x := 0; x <= fontWidth; x++ {
+			bits := uint8(0)
+			if x < fontWidth {
+				bits = glyph[x]
+			}
+			f := make(Frame, k.length)
+			for i, row := range rows {
+				if bits&(1<<row) != 0 {
+					f[i] = c
+				} else {
+					f[i] = k.background
+				}
+			}
+			frames = append(frames, SPattern{f})
+		}
+	}
+	if len(frames) == 0 && k.gapMS == 0 {
+		return &Cycle{Frames: []SPattern{{&k.background}}}
+	}
+	cycle := &Cycle{Frames: frames, FrameDurationMS: column}
+	if k.gapMS != 0 {
+		cycle.Frames = append(frames, SPattern{&k.background})
+		cycle.DurationsMS = make([]uint32, len(cycle.Frames))
+		for i := range frames {
+			cycle.DurationsMS[i] = column
+		}
+		cycle.DurationsMS[len(frames)] = k.gapMS
+	}
+	return cycle
+}
+
+const (
+	fontWidth  = 5
+	fontHeight = 7
+)
+
+// font5x7 is a 5x7 font for printable ASCII, one byte per column with bit 0
+// being the top row.
+var font5x7 = []uint8{
+	0x00, 0x00, 0x00, 0x00, 0x00, // ' '
+	0x00, 0x00, 0x5F, 0x00, 0x00, // '!'
+	0x00, 0x07, 0x00, 0x07, 0x00, // '"'
+	0x14, 0x7F, 0x14, 0x7F, 0x14, // '#'
+	0x24, 0x2A, 0x7F, 0x2A, 0x12, // '$'
+	0x23, 0x13, 0x08, 0x64, 0x62, // '%'
+	0x36, 0x49, 0x55, 0x22, 0x50, // '&'
+	0x00, 0x05, 0x03, 0x00, 0x00, // '\''
+	0x00, 0x1C, 0x22, 0x41, 0x00, // '('
+	0x00, 0x41, 0x22, 0x1C, 0x00, // ')'
+	0x14, 0x08, 0x3E, 0x08, 0x14, // '*'
+	0x08, 0x08, 0x3E, 0x08, 0x08, // '+'
+	0x00, 0x50, 0x30, 0x00, 0x00, // ','
+	0x08, 0x08, 0x08, 0x08, 0x08, // '-'
+	0x00, 0x60, 0x60, 0x00, 0x00, // '.'
+	0x20, 0x10, 0x08, 0x04, 0x02, // '/'
+	0x3E, 0x51, 0x49, 0x45, 0x3E, // '0'
+	0x00, 0x42, 0x7F, 0x40, 0x00, // '1'
+	0x42, 0x61, 0x51, 0x49, 0x46, // '2'
+	0x21, 0x41, 0x45, 0x4B, 0x31, // '3'
+	0x18, 0x14, 0x12, 0x7F, 0x10, // '4'
+	0x27, 0x45, 0x45, 0x45, 0x39, // '5'
+	0x3C, 0x4A, 0x49, 0x49, 0x30, // '6'
+	0x01, 0x71, 0x09, 0x05, 0x03, // '7'
+	0x36, 0x49, 0x49, 0x49, 0x36, // '8'
+	0x06, 0x49, 0x49, 0x29, 0x1E, // '9'
+	0x00, 0x36, 0x36, 0x00, 0x00, // ':'
+	0x00, 0x56, 0x36, 0x00, 0x00, // ';'
+	0x08, 0x14, 0x22, 0x41, 0x00, // '<'
+	0x14, 0x14, 0x14, 0x14, 0x14, // '='
+	0x00, 0x41, 0x22, 0x14, 0x08, // '>'
+	0x02, 0x01, 0x51, 0x09, 0x06, // '?'
+	0x32, 0x49, 0x79, 0x41, 0x3E, // '@'
+	0x7E, 0x11, 0x11, 0x11, 0x7E, // 'A'
+	0x7F, 0x49, 0x49, 0x49, 0x36, // 'B'
+	0x3E, 0x41, 0x41, 0x41, 0x22, // 'C'
+	0x7F, 0x41, 0x41, 0x22, 0x1C, // 'D'
+	0x7F, 0x49, 0x49, 0x49, 0x41, // 'E'
+	0x7F, 0x09, 0x09, 0x09, 0x01, // 'F'
+	0x3E, 0x41, 0x49, 0x49, 0x7A, // 'G'
+	0x7F, 0x08, 0x08, 0x08, 0x7F, // 'H'
+	0x00, 0x41, 0x7F, 0x41, 0x00, // 'I'
+	0x20, 0x40, 0x41, 0x3F, 0x01, // 'J'
+	0x7F, 0x08, 0x14, 0x22, 0x41, // 'K'
+	0x7F, 0x40, 0x40, 0x40, 0x40, // 'L'
+	0x7F, 0x02, 0x0C, 0x02, 0x7F, // 'M'
+	0x7F, 0x04, 0x08, 0x10, 0x7F, // 'N'
+	0x3E, 0x41, 0x41, 0x41, 0x3E, // 'O'
+	0x7F, 0x09, 0x09, 0x09, 0x06, // 'P'
+	0x3E, 0x41, 0x51, 0x21, 0x5E, // 'Q'
+	0x7F, 0x09, 0x19, 0x29, 0x46, // 'R'
+	0x46, 0x49, 0x49, 0x49, 0x31, // 'S'
+	0x01, 0x01, 0x7F, 0x01, 0x01, // 'T'
+	0x3F, 0x40, 0x40, 0x40, 0x3F, // 'U'
+	0x1F, 0x20, 0x40, 0x20, 0x1F, // 'V'
+	0x3F, 0x40, 0x38, 0x40, 0x3F, // 'W'
+	0x63, 0x14, 0x08, 0x14, 0x63, // 'X'
+	0x07, 0x08, 0x70, 0x08, 0x07, // 'Y'
+	0x61, 0x51, 0x49, 0x45, 0x43, // 'Z'
+	0x00, 0x7F, 0x41, 0x41, 0x00, // '['
+	0x02, 0x04, 0x08, 0x10, 0x20, // '\\'
+	0x00, 0x41, 0x41, 0x7F, 0x00, // ']'
+	0x04, 0x02, 0x01, 0x02, 0x04, // '^'
+	0x40, 0x40, 0x40, 0x40, 0x40, // '_'
+	0x00, 0x01, 0x02, 0x04, 0x00, // '`'
+	0x20, 0x54, 0x54, 0x54, 0x78, // 'a'
+	0x7F, 0x48, 0x44, 0x44, 0x38, // 'b'
+	0x38, 0x44, 0x44, 0x44, 0x20, // 'c'
+	0x38, 0x44, 0x44, 0x48, 0x7F, // 'd'
+	0x38, 0x54, 0x54, 0x54, 0x18, // 'e'
+	0x08, 0x7E, 0x09, 0x01, 0x02, // 'f'
+	0x0C, 0x52, 0x52, 0x52, 0x3E, // 'g'
+	0x7F, 0x08, 0x04, 0x04, 0x78, // 'h'
+	0x00, 0x44, 0x7D, 0x40, 0x00, // 'i'
+	0x20, 0x40, 0x44, 0x3D, 0x00, // 'j'
+	0x7F, 0x10, 0x28, 0x44, 0x00, // 'k'
+	0x00, 0x41, 0x7F, 0x40, 0x00, // 'l'
+	0x7C, 0x04, 0x18, 0x04, 0x78, // 'm'
+	0x7C, 0x08, 0x04, 0x04, 0x78, // 'n'
+	0x38, 0x44, 0x44, 0x44, 0x38, // 'o'
+	0x7C, 0x14, 0x14, 0x14, 0x08, // 'p'
+	0x08, 0x14, 0x14, 0x18, 0x7C, // 'q'
+	0x7C, 0x08, 0x04, 0x04, 0x08, // 'r'
+	0x48, 0x54, 0x54, 0x54, 0x20, // 's'
+	0x04, 0x3F, 0x44, 0x40, 0x20, // 't'
+	0x3C, 0x40, 0x40, 0x20, 0x7C, // 'u'
+	0x1C, 0x20, 0x40, 0x20, 0x1C, // 'v'
+	0x3C, 0x40, 0x30, 0x40, 0x3C, // 'w'
+	0x44, 0x28, 0x10, 0x28, 0x44, // 'x'
+	0x0C, 0x50, 0x50, 0x50, 0x3C, // 'y'
+	0x44, 0x64, 0x54, 0x4C, 0x44, // 'z'
+	0x00, 0x08, 0x36, 0x41, 0x00, // '{'
+	0x00, 0x00, 0x7F, 0x00, 0x00, // '|'
+	0x00, 0x41, 0x36, 0x08, 0x00, // '}'
+	0x10, 0x08, 0x08, 0x10, 0x08, // '~'
+}
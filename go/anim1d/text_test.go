@@ -0,0 +1,71 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"testing"
+
+	"github.com/maruel/ut"
+)
+
+func TestMorseUnits(t *testing.T) {
+	ut.AssertEqual(t, []uint32(nil), morseUnits(""))
+	ut.AssertEqual(t, []uint32(nil), morseUnits(" #"))
+	ut.AssertEqual(t, []uint32{1, 7, 1, 7}, morseUnits("e  e"))
+	ut.AssertEqual(t, []uint32{1, 1, 1, 1, 1, 3, 3, 1, 3, 1, 3, 3, 1, 1, 1, 1, 1, 7}, morseUnits("SOS"))
+}
+
+func TestMorse(t *testing.T) {
+	w := Color{0xFF, 0xFF, 0xFF}
+	testFrames(t, &Morse{Text: "ET", UnitMS: 10}, []expectation{
+		{0, Frame{w, w}},
+		{9, Frame{w, w}},
+		{10, Frame{{}, {}}},
+		{39, Frame{{}, {}}},
+		{40, Frame{w, w}},
+		{69, Frame{w, w}},
+		{70, Frame{{}, {}}},
+		{139, Frame{{}, {}}},
+		{140, Frame{w, w}},
+	})
+	red := Color{0xFF, 0x00, 0x00}
+	testFrames(t, &Morse{Text: "E", Child: SPattern{&red}}, []expectation{
+		{0, Frame{red}},
+		{100, Frame{{}}},
+		{800, Frame{red}},
+	})
+	testFrame(t, &Morse{}, expectation{0, Frame{{}, {}}})
+}
+
+func TestText(t *testing.T) {
+	w := Color{0xFF, 0xFF, 0xFF}
+	b := Color{0x00, 0x00, 0x10}
+	p := &Text{Text: "I", Background: b, ColumnMS: 10}
+	testFrames(t, p, []expectation{
+		{0, Frame{b, b, b, b, b, b, b}},
+		{10, Frame{w, b, b, b, b, b, w}},
+		{20, Frame{w, w, w, w, w, w, w}},
+		{30, Frame{w, b, b, b, b, b, w}},
+		{40, Frame{b, b, b, b, b, b, b}},
+		{50, Frame{b, b, b, b, b, b, b}},
+		{60, Frame{b, b, b, b, b, b, b}},
+		{70, Frame{w, b, b, b, b, b, w}},
+	})
+	// The glyphs are stretched and pixel 0 is the bottom.
+	red := Color{0xFF, 0x00, 0x00}
+	p = &Text{Text: "_.", Color: red, ColumnMS: 10, GapMS: 100}
+	testFrames(t, p, []expectation{
+		{0, Frame{red, red, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{60, Frame{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{70, Frame{red, red, red, red, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{80, Frame{red, red, red, red, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{90, Frame{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{120, Frame{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{219, Frame{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+		{220, Frame{red, red, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}}},
+	})
+	// Unsupported characters are shown as '?'.
+	ut.AssertEqual(t, textCycle(textKey{text: "?", length: 7}), textCycle(textKey{text: "é", length: 7}))
+}
@@ -0,0 +1,223 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import "math"
+
+// Value is a numeric parameter that can be animated over time.
+//
+// Like Pattern, it must be a pure function of timeMS so multiple devices stay
+// synchronized.
+type Value interface {
+	// Eval returns the value at this time frame.
+	Eval(timeMS uint32) float32
+}
+
+// Const is a Value that never changes.
+//
+// It is serialized as a plain JSON number.
+type Const float32
+
+func (c Const) Eval(timeMS uint32) float32 {
+	return float32(c)
+}
+
+// Ramp goes from From to To over DurationMS.
+type Ramp struct {
+	From       float32        // Value before and at OffsetMS
+	To         float32        // Value at and after OffsetMS+DurationMS
+	OffsetMS   uint32         // Time at which the ramp starts
+	DurationMS uint32         // Duration of the ramp
+	Transition TransitionType // Curve of the ramp, defaults to Linear
+}
+
+func (r *Ramp) Eval(timeMS uint32) float32 {
+	if timeMS <= r.OffsetMS {
+		return r.From
+	}
+	if timeMS-r.OffsetMS >= r.DurationMS {
+		return r.To
+	}
+	return interpolate(r.From, r.To, r.Transition, float32(timeMS-r.OffsetMS)/float32(r.DurationMS))
+}
+
+// LFO is a low frequency sine oscillator.
+//
+// It starts at Min, reaches Max at half the period and goes back to Min.
+type LFO struct {
+	Min      float32
+	Max      float32
+	PeriodMS uint32 // Duration of a full oscillation; Min is returned when 0
+	OffsetMS uint32 // Phase offset
+}
+
+func (l *LFO) Eval(timeMS uint32) float32 {
+	if l.PeriodMS == 0 {
+		return l.Min
+	}
+	// Do the modulo first to keep float32 precision.
+	phase := float32((timeMS+l.OffsetMS)%l.PeriodMS) / float32(l.PeriodMS)
+	return l.Min + (l.Max-l.Min)*(1-sin(2*math.Pi*phase+0.5*math.Pi))*0.5
+}
+
+// Keyframe is one point of Keyframes.
+type Keyframe struct {
+	TimeMS     uint32
+	Value      float32
+	Transition TransitionType // Curve to the next keyframe, defaults to Linear. Use cubic-bezier() for a bezier curve
+}
+
+// Keyframes interpolates between values at specific times.
+//
+// Keyframes must be sorted by TimeMS. The first value is returned before the
+// first keyframe and the last value after the last keyframe.
+type Keyframes struct {
+	Keyframes []Keyframe
+	LoopMS    uint32 // When set, the time is looped over this period
+}
+
+func (k *Keyframes) Eval(timeMS uint32) float32 {
+	if len(k.Keyframes) == 0 {
+		return 0
+	}
+	if k.LoopMS != 0 {
+		timeMS %= k.LoopMS
+	}
+	i := 0
+	for i < len(k.Keyframes)-1 && k.Keyframes[i+1].TimeMS <= timeMS {
+		i++
+	}
+	f := k.Keyframes[i]
+	if i == len(k.Keyframes)-1 || timeMS <= f.TimeMS {
+		return f.Value
+	}
+	n := k.Keyframes[i+1]
+	return interpolate(f.Value, n.Value, f.Transition, float32(timeMS-f.TimeMS)/float32(n.TimeMS-f.TimeMS))
+}
+
+// Wander drifts smoothly between Min and Max.
+//
+// It is smoothed value noise: a new random target is reached every StepMS and
+// the value moves smoothly in between. Unlike a random walk it doesn't
+// accumulate steps, so it is a pure function of timeMS and Seed.
+type Wander struct {
+	Min    float32
+	Max    float32
+	StepMS uint32 // Defaults to 1000
+	Seed   int
+}
+
+func (w *Wander) Eval(timeMS uint32) float32 {
+	step := w.StepMS
+	if step == 0 {
+		step = 1000
+	}
+	return w.Min + (w.Max-w.Min)*valueNoise(uint32(w.Seed), float64(timeMS)/float64(step))
+}
+
+// integrable is implemented by the values that can be integrated exactly.
+type integrable interface {
+	// integrate returns the integral of the value from 0 to timeMS, in value
+	// times seconds.
+	integrate(timeMS uint32) float64
+}
+
+func (r *Ramp) integrate(timeMS uint32) float64 {
+	if timeMS <= r.OffsetMS {
+		return float64(r.From) * float64(timeMS) / 1000
+	}
+	sum := float64(r.From) * float64(r.OffsetMS) / 1000
+	d := timeMS - r.OffsetMS
+	if d > r.DurationMS {
+		sum += float64(r.To) * float64(d-r.DurationMS) / 1000
+		d = r.DurationMS
+	}
+	if d == 0 {
+		return sum
+	}
+	// The curve of the ramp itself uses Simpson's rule.
+	const n = 32
+	h := float64(d) / n
+	f := func(k int) float64 {
+		return float64(interpolate(r.From, r.To, r.Transition, float32(float64(k)*h/float64(r.DurationMS))))
+	}
+	s := f(0) + f(n)
+	for k := 1; k < n; k++ {
+		s += float64(2+2*(k&1)) * f(k)
+	}
+	return sum + s*h/3000
+}
+
+func (l *LFO) integrate(timeMS uint32) float64 {
+	t := float64(timeMS) / 1000
+	if l.PeriodMS == 0 {
+		return float64(l.Min) * t
+	}
+	// The integral of (1-cos(2πx/P))/2.
+	p := float64(l.PeriodMS) / 1000
+	o := float64(l.OffsetMS%l.PeriodMS) / 1000
+	w := 2 * math.Pi / p
+	return float64(l.Min)*t + float64(l.Max-l.Min)*0.5*(t-(math.Sin(w*(t+o))-math.Sin(w*o))/w)
+}
+
+// integrateStepMS is the resolution used to integrate the values that do not
+// implement integrable.
+const integrateStepMS = 10
+
+// integral integrates a Value over time, for example a speed into a position.
+//
+// Const, Ramp and LFO are integrated exactly. Other values are integrated
+// with the trapezoidal rule over fixed steps from 0 so the result only
+// depends on timeMS and not on the frame rate. The sum is cached so
+// successive frames only integrate the new steps.
+type integral struct {
+	stepMS uint32  // Last step integrated in sum
+	sum    float64 // Integral from 0 to stepMS
+}
+
+// at returns the integral of v from 0 to timeMS, in value times seconds.
+func (i *integral) at(v SValue, timeMS uint32) float64 {
+	switch x := v.Value.(type) {
+	case nil:
+		return 0
+	case Const:
+		return float64(x) * float64(timeMS) / 1000
+	case integrable:
+		return x.integrate(timeMS)
+	}
+	if timeMS < i.stepMS {
+		*i = integral{}
+	}
+	for timeMS-i.stepMS >= integrateStepMS {
+		i.sum += float64(v.Eval(i.stepMS)+v.Eval(i.stepMS+integrateStepMS)) * integrateStepMS / 2000
+		i.stepMS += integrateStepMS
+	}
+	return i.sum + float64(v.Eval(i.stepMS)+v.Eval(timeMS))*float64(timeMS-i.stepMS)/2000
+}
+
+// valueOr returns v, or the constant d when v is not set.
+func valueOr(v *SValue, d Const) SValue {
+	if v == nil {
+		return SValue{d}
+	}
+	return *v
+}
+
+// evalOr returns v evaluated at timeMS, or d when v is not set.
+func evalOr(v *SValue, d float32, timeMS uint32) float32 {
+	if v == nil {
+		return d
+	}
+	return v.Eval(timeMS)
+}
+
+// interpolate returns the value between a and b at f in [0, 1] following the
+// transition t, which defaults to Linear.
+func interpolate(a, b float32, t TransitionType, f float32) float32 {
+	if t == "" {
+		t = TransitionLinear
+	}
+	return a + (b-a)*t.scale(f)
+}
@@ -0,0 +1,123 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maruel/ut"
+)
+
+func TestValueNil(t *testing.T) {
+	ut.AssertEqual(t, float32(0), SValue{}.Eval(1000))
+	ut.AssertEqual(t, float32(2), SValue{Const(2)}.Eval(1000))
+	for _, v := range knownValues {
+		// Values must be callable with their default values.
+		v.Eval(0)
+		v.Eval(1000)
+	}
+}
+
+func TestRamp(t *testing.T) {
+	r := &Ramp{From: 10, To: 20, OffsetMS: 1000, DurationMS: 1000}
+	data := []struct {
+		timeMS   uint32
+		expected float32
+	}{
+		{0, 10}, {1000, 10}, {1250, 12.5}, {1500, 15}, {2000, 20}, {5000, 20},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, r.Eval(line.timeMS))
+	}
+	r.Transition = TransitionStepStart
+	ut.AssertEqual(t, float32(20), r.Eval(1001))
+}
+
+func TestLFO(t *testing.T) {
+	l := &LFO{Min: 1, Max: 3, PeriodMS: 1000}
+	data := []struct {
+		timeMS   uint32
+		expected float32
+	}{
+		{0, 1}, {250, 2}, {500, 3}, {750, 2}, {1000, 1}, {10500, 3},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, true, abs(line.expected-l.Eval(line.timeMS)) < 0.001)
+	}
+	l.OffsetMS = 500
+	ut.AssertEqual(t, true, abs(3-l.Eval(0)) < 0.001)
+}
+
+func TestKeyframes(t *testing.T) {
+	k := &Keyframes{
+		Keyframes: []Keyframe{
+			{TimeMS: 1000, Value: 0},
+			{TimeMS: 2000, Value: 10, Transition: TransitionEaseIn},
+			{TimeMS: 4000, Value: 20},
+		},
+	}
+	data := []struct {
+		timeMS   uint32
+		expected float32
+	}{
+		{0, 0}, {1000, 0}, {1500, 5}, {2000, 10}, {3000, 10 + 10*TransitionEaseIn.scale(0.5)}, {4000, 20}, {9000, 20},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, k.Eval(line.timeMS))
+	}
+	k.LoopMS = 4000
+	ut.AssertEqual(t, float32(0), k.Eval(4000))
+	ut.AssertEqual(t, float32(5), k.Eval(5500))
+}
+
+func TestWander(t *testing.T) {
+	r := &Wander{Min: -1, Max: 1, StepMS: 100, Seed: 2}
+	prev := r.Eval(0)
+	for i := uint32(1); i < 1000; i++ {
+		v := r.Eval(i)
+		ut.AssertEqual(t, true, v >= -1 && v <= 1)
+		// It doesn't jump.
+		ut.AssertEqual(t, true, abs(v-prev) < 0.1)
+		prev = v
+	}
+	// Deterministic.
+	ut.AssertEqual(t, r.Eval(12345), (&Wander{Min: -1, Max: 1, StepMS: 100, Seed: 2}).Eval(12345))
+	ut.AssertEqual(t, false, r.Eval(12345) == (&Wander{Min: -1, Max: 1, StepMS: 100, Seed: 3}).Eval(12345))
+}
+
+func TestIntegral(t *testing.T) {
+	data := []struct {
+		v        SValue
+		timeMS   uint32
+		expected float64
+	}{
+		{SValue{}, 1000, 0},
+		{SValue{Const(2)}, 1500, 3},
+		{SValue{&Ramp{From: 1, To: 3, OffsetMS: 1000, DurationMS: 2000}}, 500, 0.5},
+		{SValue{&Ramp{From: 1, To: 3, OffsetMS: 1000, DurationMS: 2000}}, 2000, 2.5},
+		{SValue{&Ramp{From: 1, To: 3, OffsetMS: 1000, DurationMS: 2000}}, 4000, 8},
+		{SValue{&Ramp{From: 1, To: 3, OffsetMS: 1000, DurationMS: 2000, Transition: TransitionEaseInOut}}, 4000, 8},
+		{SValue{&LFO{Min: 1, Max: 3, PeriodMS: 2000}}, 2000, 4},
+		{SValue{&LFO{Min: 1, Max: 3, PeriodMS: 2000}}, 1000, 2},
+		{SValue{&LFO{Min: 1, Max: 3, PeriodMS: 2000}}, 500, 0.5 + 0.5 - 1/math.Pi},
+		{SValue{&LFO{Min: 1, Max: 3, PeriodMS: 2000, OffsetMS: 500}}, 2000, 4},
+		// Integrated numerically.
+		{SValue{&Keyframes{Keyframes: []Keyframe{{1000, 1, ""}, {3000, 3, ""}}}}, 2000, 2.5},
+		{SValue{&Keyframes{Keyframes: []Keyframe{{1000, 1, ""}, {3000, 3, ""}}}}, 4000, 8},
+	}
+	for i, line := range data {
+		var in integral
+		if v := in.at(line.v, line.timeMS); math.Abs(v-line.expected) > 0.001 {
+			t.Fatalf("%d: %g != %g", i, line.expected, v)
+		}
+	}
+	// The numerical integration is cached and restarts when the time goes back.
+	var in integral
+	v := SValue{&Keyframes{Keyframes: []Keyframe{{0, 0, ""}, {1000, 1, ""}}}}
+	ut.AssertEqual(t, true, math.Abs(in.at(v, 1000)-0.5) < 0.001)
+	ut.AssertEqual(t, uint32(1000), in.stepMS)
+	ut.AssertEqual(t, true, math.Abs(in.at(v, 500)-0.125) < 0.001)
+}
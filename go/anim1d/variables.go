@@ -0,0 +1,121 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import "sync"
+
+var (
+	variablesLock sync.Mutex
+	variables     = map[string]float32{}
+)
+
+// SetVariable sets a named variable that patterns can read with Variable.
+//
+// It is meant to display external data like a temperature, a build status or
+// the progress of a task. It is safe to call while the Painter is running.
+func SetVariable(name string, value float32) {
+	variablesLock.Lock()
+	defer variablesLock.Unlock()
+	variables[name] = value
+}
+
+// DeleteVariable removes a named variable. Variable then returns its Default.
+func DeleteVariable(name string) {
+	variablesLock.Lock()
+	defer variablesLock.Unlock()
+	delete(variables, name)
+}
+
+// Variables returns a copy of all the variables set.
+func Variables() map[string]float32 {
+	variablesLock.Lock()
+	defer variablesLock.Unlock()
+	out := make(map[string]float32, len(variables))
+	for k, v := range variables {
+		out[k] = v
+	}
+	return out
+}
+
+// Variable is a Value read from a named variable set with SetVariable.
+type Variable struct {
+	Name    string
+	Default float32 // Value while the variable is not set
+}
+
+func (v *Variable) Eval(timeMS uint32) float32 {
+	variablesLock.Lock()
+	defer variablesLock.Unlock()
+	if x, ok := variables[v.Name]; ok {
+		return x
+	}
+	return v.Default
+}
+
+// Bar fills a part of the strip proportionally to a value, e.g. a progress
+// percentage, starting from pixel 0.
+type Bar struct {
+	Child SPattern // Pattern of the filled part, defaults to white; it is rendered on the whole strip so the color can vary along the bar
+	Value SValue   // Usually a Variable
+	Min   float32  // Value showing an empty bar
+	Max   float32  // Value showing a full bar; 0 means 100 so use a tiny value like 1e-6 for a bar ending at 0
+}
+
+func (b *Bar) NextFrame(pixels Frame, timeMS uint32) {
+	max := b.Max
+	if max == 0 {
+		max = 100
+	}
+	f := float32(0)
+	if max != b.Min {
+		f = (b.Value.Eval(timeMS) - b.Min) / (max - b.Min)
+	}
+	if b.Child.Pattern != nil {
+		b.Child.NextFrame(pixels, timeMS)
+	} else {
+		for i := range pixels {
+			pixels[i] = Color{0xFF, 0xFF, 0xFF}
+		}
+	}
+	// In 24.8 fixed point.
+	n := int(clamp01(f)*float32(len(pixels))*256 + 0.5)
+	for i := range pixels {
+		if w := n - i<<8; w <= 0 {
+			pixels[i] = Color{}
+		} else if w < 256 {
+			pixels[i] = scaleColor(pixels[i], uint16(w))
+		}
+	}
+}
+
+// ThresholdStep is one step of Threshold.
+type ThresholdStep struct {
+	Min     float32 // Lowest value for which Pattern is shown
+	Pattern SPattern
+}
+
+// Threshold shows a different pattern depending on a value, e.g. blue when
+// it's cold and red when it's hot.
+//
+// Steps must be sorted by Min. The first step is shown when the value is
+// lower than all the steps.
+type Threshold struct {
+	Value SValue // Usually a Variable
+	Steps []ThresholdStep
+}
+
+func (t *Threshold) NextFrame(pixels Frame, timeMS uint32) {
+	if len(t.Steps) == 0 {
+		return
+	}
+	v := t.Value.Eval(timeMS)
+	i := 0
+	for i < len(t.Steps)-1 && t.Steps[i+1].Min <= v {
+		i++
+	}
+	if t.Steps[i].Pattern.Pattern != nil {
+		t.Steps[i].Pattern.NextFrame(pixels, timeMS)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package anim1d
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/maruel/ut"
+)
+
+func TestVariable(t *testing.T) {
+	defer DeleteVariable("temp")
+	v := &Variable{Name: "temp", Default: 20}
+	ut.AssertEqual(t, float32(20), v.Eval(0))
+	SetVariable("temp", 25.5)
+	ut.AssertEqual(t, float32(25.5), v.Eval(0))
+	ut.AssertEqual(t, map[string]float32{"temp": 25.5}, Variables())
+	DeleteVariable("temp")
+	ut.AssertEqual(t, float32(20), v.Eval(0))
+	ut.AssertEqual(t, map[string]float32{}, Variables())
+}
+
+func TestBar(t *testing.T) {
+	defer DeleteVariable("progress")
+	w := Color{0xFF, 0xFF, 0xFF}
+	b := &Bar{Value: SValue{&Variable{Name: "progress"}}}
+	testFrame(t, b, expectation{0, Frame{{}, {}, {}, {}}})
+	SetVariable("progress", 50)
+	testFrame(t, b, expectation{0, Frame{w, w, {}, {}}})
+	testFrame(t, b, expectation{0, Frame{w, w, {0x80, 0x80, 0x80}, {}, {}}})
+	SetVariable("progress", 150)
+	testFrame(t, b, expectation{0, Frame{w, w, w, w}})
+	// Custom range and child.
+	red := Color{0xFF, 0x00, 0x00}
+	b = &Bar{Child: SPattern{&red}, Value: SValue{Const(15)}, Min: 10, Max: 30}
+	testFrame(t, b, expectation{0, Frame{red, {}, {}, {}}})
+}
+
+func TestThreshold(t *testing.T) {
+	defer DeleteVariable("build")
+	red := Color{0xFF, 0x00, 0x00}
+	green := Color{0x00, 0xFF, 0x00}
+	blue := Color{0x00, 0x00, 0xFF}
+	p := &Threshold{
+		Value: SValue{&Variable{Name: "build", Default: -1}},
+		Steps: []ThresholdStep{{0, SPattern{&red}}, {1, SPattern{&green}}, {2, SPattern{&blue}}},
+	}
+	data := []struct {
+		v        float32
+		expected Color
+	}{
+		{-10, red}, {0, red}, {0.5, red}, {1, green}, {1.9, green}, {2, blue}, {100, blue},
+	}
+	for i, line := range data {
+		SetVariable("build", line.v)
+		pixels := make(Frame, 1)
+		p.NextFrame(pixels, 0)
+		ut.AssertEqualIndex(t, i, line.expected, pixels[0])
+	}
+	var s SPattern
+	ut.AssertEqual(t, nil, json.Unmarshal([]byte(`{"Value":{"Name":"build","_type":"Variable"},"Steps":[{"Min":0,"Pattern":"#ff0000"}],"_type":"Threshold"}`), &s))
+	ut.AssertEqual(t, SValue{&Variable{Name: "build"}}, s.Pattern.(*Threshold).Value)
+}
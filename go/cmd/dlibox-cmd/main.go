@@ -4,6 +4,8 @@
 
 // dlibox-cmd is meant to run on a host to query via mDNS and MQTT the current
 // dlibox instances.
+//
+// It can also set variables on a dlibox instance with -host and -set.
 package main
 
 import (
@@ -11,8 +13,13 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -82,8 +89,54 @@ func query() error {
 	return nil
 }
 
+// variables is a repeatable -set flag of name=value pairs.
+type variables map[string]string
+
+func (v variables) String() string {
+	var out []string
+	for name, value := range v {
+		out = append(out, name+"="+value)
+	}
+	return strings.Join(out, ",")
+}
+
+func (v variables) Set(s string) error {
+	i := strings.IndexByte(s, '=')
+	if i <= 0 {
+		return fmt.Errorf("expected name=value, got %q", s)
+	}
+	if value := s[i+1:]; len(value) != 0 {
+		if f, err := strconv.ParseFloat(value, 32); err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	}
+	v[s[:i]] = s[i+1:]
+	return nil
+}
+
+// setVariables sets the variables on the dlibox instance at host. An empty
+// value deletes the variable.
+func setVariables(host string, vars variables) error {
+	for name, value := range vars {
+		log.Printf("%s: %s = %q", host, name, value)
+		resp, err := http.PostForm("http://"+host+"/variable", url.Values{"name": {name}, "value": {value}})
+		if err != nil {
+			return err
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to set %s: %s", name, strings.TrimSpace(string(b)))
+		}
+	}
+	return nil
+}
+
 func mainImpl() error {
 	verbose := flag.Bool("verbose", false, "enable log output")
+	host := flag.String("host", "", "dlibox instance to talk to, as host:port")
+	vars := variables{}
+	flag.Var(vars, "set", "set a variable as name=value, can be repeated; an empty value deletes it")
 	flag.Parse()
 	if flag.NArg() != 0 {
 		return fmt.Errorf("unexpected argument: %s", flag.Args())
@@ -93,6 +146,12 @@ func mainImpl() error {
 		log.SetOutput(ioutil.Discard)
 	}
 
+	if len(vars) != 0 {
+		if len(*host) == 0 {
+			return fmt.Errorf("-set requires -host")
+		}
+		return setVariables(*host, vars)
+	}
 	return query()
 }
 
@@ -0,0 +1,83 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/maruel/dlibox/go/anim1d"
+	"github.com/pkg/errors"
+)
+
+// startAudio sets the audio input used by the audio reactive patterns.
+//
+// src is "-" to read raw PCM from stdin, "alsa" or "alsa:<device>" to record
+// from an ALSA device with arecord, or the path to a WAV or raw PCM file. A
+// file is played in sync with the pattern. Raw PCM is signed 16 bits little
+// endian.
+//
+// The returned function stops the recording.
+func startAudio(src string, sampleRate, channels int) (func(), error) {
+	stop := func() {}
+	switch {
+	case src == "-":
+		live := anim1d.MakeLiveAudio(sampleRate, channels)
+		go copyAudio(live, os.Stdin)
+		anim1d.SetAudioInput(live)
+
+	case src == "alsa" || strings.HasPrefix(src, "alsa:"):
+		args := []string{"-q", "-t", "raw", "-f", "S16_LE", "-r", strconv.Itoa(sampleRate), "-c", strconv.Itoa(channels)}
+		if dev := strings.TrimPrefix(strings.TrimPrefix(src, "alsa"), ":"); dev != "" {
+			args = append(args, "-D", dev)
+		}
+		cmd := exec.Command("arecord", args...)
+		r, err := cmd.StdoutPipe()
+		if err != nil {
+			return nil, err
+		}
+		if err := cmd.Start(); err != nil {
+			return nil, errors.Wrap(err, "can't start arecord")
+		}
+		live := anim1d.MakeLiveAudio(sampleRate, channels)
+		go copyAudio(live, r)
+		anim1d.SetAudioInput(live)
+		stop = func() {
+			cmd.Process.Kill()
+			cmd.Wait()
+		}
+
+	default:
+		f, err := os.Open(src)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		var p *anim1d.PCM
+		if strings.HasSuffix(strings.ToLower(src), ".wav") {
+			p, err = anim1d.ReadWAV(f)
+		} else {
+			p, err = anim1d.ReadPCM(f, sampleRate, channels)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "can't load audio file")
+		}
+		anim1d.SetAudioInput(p)
+	}
+	return func() {
+		anim1d.SetAudioInput(nil)
+		stop()
+	}, nil
+}
+
+func copyAudio(w io.Writer, r io.Reader) {
+	if _, err := io.Copy(w, r); err != nil {
+		log.Printf("Audio input failed: %s", err)
+	}
+}
@@ -22,6 +22,8 @@ type APA102 struct {
 	// number of lights, the remaining lights will flash oddly.
 	NumberLights   int
 	StartupPattern string
+	// Layout of the transition when switching patterns, defaults to a fade.
+	TransitionStyle anim1d.TransitionStyle
 }
 
 // Config stores the configuration for this specific host.
@@ -65,19 +67,20 @@ func (c *Config) ResetDefault() {
 		Patterns: []string{
 			"{\"_type\":\"Aurore\"}",
 			"{\"MovesPerSec\":6,\"Child\":{\"Frame\":\"Lff0000ff0000ff0000ff0000ff0000ffffffffffffffffffffffffffffff\",\"_type\":\"Repeated\"},\"_type\":\"Rotate\"}",
-			"{\"Patterns\":[{\"_type\":\"Aurore\"},{\"Seed\":0,\"Stars\":null,\"_type\":\"NightStars\"},{\"AverageDelay\":0,\"Duration\":0,\"_type\":\"WishingStar\"}],\"Weights\":[1,1,1],\"_type\":\"Mixer\"}",
-			"{\"DurationShowMS\":1000000,\"DurationTransitionMS\":1000000,\"Patterns\":[\"#ff0000\",\"#00ff00\",\"#0000ff\"],\"Transition\":\"easeinout\",\"_type\":\"Loop\"}",
+			"{\"Aurore\":{},\"AuroreIntensity\":1,\"Stars\":{\"Density\":0.3,\"Intensity\":255,\"Seed\":0},\"Supernova\":{\"AverageDelayMS\":60000,\"Color\":\"#ffffff\",\"DurationMS\":5000,\"Seed\":0,\"Size\":5},\"WishingStar\":{\"AverageDelayMS\":10000,\"Color\":\"#ffffff\",\"DurationMS\":1000,\"Length\":10,\"MovesPerSec\":60,\"Seed\":0},\"_type\":\"NightSky\"}",
+			"{\"DurationShowMS\":1000000,\"DurationTransitionMS\":1000000,\"Patterns\":[\"#ff0000\",\"#00ff00\",\"#0000ff\"],\"Transition\":\"ease-in-out\",\"_type\":\"Loop\"}",
 			"{\"Left\":\"#000000\",\"Right\":\"#0000ff\",\"Transition\":\"linear\",\"_type\":\"Gradient\"}",
 			"{\"Left\":\"#000000\",\"Right\":\"#ff0000\",\"Transition\":\"linear\",\"_type\":\"Gradient\"}",
 			"{\"Left\":\"#000000\",\"Right\":\"#00ff00\",\"Transition\":\"linear\",\"_type\":\"Gradient\"}",
 			"{\"Left\":\"#000000\",\"Right\":\"#ffffff\",\"Transition\":\"linear\",\"_type\":\"Gradient\"}",
 			"{\"Child\":\"Lff0000ff0000ee0000dd0000cc0000bb0000aa0000990000880000770000660000550000440000330000220000110000\",\"MovesPerSec\":30,\"_type\":\"PingPong\"}",
-			"{\"Duration\":600000000000,\"After\":\"#000000\",\"Offset\":1800000000000,\"Before\":{\"Duration\":600000000000,\"After\":\"#ffffff\",\"Offset\":600000000000,\"Before\":{\"Duration\":600000000000,\"After\":\"#ff7f00\",\"Offset\":0,\"Before\":\"#000000\",\"Transition\":\"linear\",\"_type\":\"Transition\"},\"Transition\":\"linear\",\"_type\":\"Transition\"},\"Transition\":\"linear\",\"_type\":\"Transition\"}",
+			"{\"Cues\":[{\"DurationMS\":600000,\"Pattern\":\"#ff7f00\",\"StartMS\":0,\"Transition\":\"linear\"},{\"DurationMS\":600000,\"Pattern\":\"#ffffff\",\"StartMS\":600000,\"Transition\":\"linear\"},{\"DurationMS\":600000,\"Pattern\":\"#000000\",\"StartMS\":1800000,\"Transition\":\"linear\"}],\"_type\":\"Timeline\"}",
 			"\"#000000\"",
 			"{\"Child\":\"Lffffff\",\"MovesPerSec\":30,\"_type\":\"PingPong\"}",
-			"{\"DurationShowMS\":1000000,\"DurationTransitionMS\":10000000,\"Patterns\":[\"#ff0000\",\"#ff7f00\",\"#ffff00\",\"#00ff00\",\"#0000ff\",\"#4b0082\",\"#8b00ff\"],\"Transition\":\"easeinout\",\"_type\":\"Loop\"}",
+			"{\"DurationShowMS\":1000000,\"DurationTransitionMS\":10000000,\"Patterns\":[\"#ff0000\",\"#ff7f00\",\"#ffff00\",\"#00ff00\",\"#0000ff\",\"#4b0082\",\"#8b00ff\"],\"Transition\":\"ease-in-out\",\"_type\":\"Loop\"}",
 			"\"Rainbow\"",
-			"{\"Seed\":0,\"Stars\":null,\"_type\":\"NightStars\"}",
+			"{\"Density\":0.3,\"Intensity\":255,\"Seed\":0,\"_type\":\"NightStars\"}",
+			"{\"Background\":\"#000000\",\"HideSeconds\":true,\"Hour\":\"#400000\",\"Hours24\":false,\"Layout\":\"dots\",\"Minute\":\"#002000\",\"Second\":\"#000020\",\"_type\":\"Clock\"}",
 		},
 	}
 }
@@ -153,6 +156,9 @@ func (c *ConfigMgr) Init(p *anim1d.Painter) error {
 	if err := c.Alarms.Reset(p); err != nil {
 		return nil
 	}
+	if err := p.SetTransitionStyle(c.APA102.TransitionStyle); err != nil {
+		return err
+	}
 	return p.SetPattern(c.APA102.StartupPattern)
 }
 
@@ -5,8 +5,15 @@
 package main
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"testing"
 
+	"github.com/maruel/dlibox/go/anim1d"
 	"github.com/maruel/ut"
 )
 
@@ -15,3 +22,44 @@ func TestConfig(t *testing.T) {
 	c.ResetDefault()
 	ut.AssertEqual(t, nil, c.verify())
 }
+
+func TestConfigLoadLegacy(t *testing.T) {
+	// Older versions saved "easeinout" in the default recent patterns.
+	d, err := ioutil.TempDir("", "dlibox")
+	ut.AssertEqual(t, nil, err)
+	defer os.RemoveAll(d)
+	n := filepath.Join(d, "dlibox.json")
+	data := `{"APA102":{"StartupPattern":"\"#000001\""},"Patterns":["{\"DurationShowMS\":1000000,\"DurationTransitionMS\":1000000,\"Patterns\":[\"#ff0000\",\"#00ff00\"],\"Transition\":\"easeinout\",\"_type\":\"Loop\"}"]}`
+	ut.AssertEqual(t, nil, ioutil.WriteFile(n, []byte(data), 0600))
+	c := Config{}
+	ut.AssertEqual(t, nil, c.Load(n))
+	ut.AssertEqual(t, 1, len(c.Patterns))
+}
+
+func TestWebPresets(t *testing.T) {
+	// The presets in root.html must be valid and match the default patterns.
+	c := Config{}
+	c.ResetDefault()
+	defaults := map[string]bool{}
+	for _, p := range c.Patterns {
+		defaults[p] = true
+	}
+	html := string(mustRead("root.html"))
+	block := regexp.MustCompile(`(?s)var patterns = \{(.*?)\};`).FindStringSubmatch(html)
+	ut.AssertEqual(t, 2, len(block))
+	presets := regexp.MustCompile(`"((?:[^"\\]|\\.)*)":\s*("(?:[^"\\]|\\.)*")`).FindAllStringSubmatch(block[1], -1)
+	if len(presets) == 0 {
+		t.Fatal("no preset found")
+	}
+	for _, m := range presets {
+		v, err := strconv.Unquote(m[2])
+		ut.AssertEqual(t, nil, err)
+		var p anim1d.SPattern
+		if err := json.Unmarshal([]byte(v), &p); err != nil {
+			t.Fatalf("%s: %v", m[1], err)
+		}
+		if !defaults[v] {
+			t.Fatalf("%s is not in the default patterns", m[1])
+		}
+	}
+}
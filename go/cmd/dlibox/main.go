@@ -34,6 +34,9 @@ func mainImpl() error {
 	port := flag.Int("port", 8010, "http port to listen on")
 	verbose := flag.Bool("verbose", false, "enable log output")
 	fake := flag.Bool("fake", false, "use a terminal mock, useful to test without the hardware")
+	audio := flag.String("audio", "", "audio input for audio reactive patterns; \"-\" for raw PCM on stdin, \"alsa[:device]\" or a WAV or raw PCM file")
+	audioRate := flag.Int("audio-rate", 44100, "sample rate of raw PCM audio input")
+	audioChannels := flag.Int("audio-channels", 1, "number of channels of raw PCM audio input")
 	flag.Parse()
 	if flag.NArg() != 0 {
 		return fmt.Errorf("unexpected argument: %s", flag.Args())
@@ -59,6 +62,15 @@ func mainImpl() error {
 		properties = append(properties, "profiled=1")
 	}
 
+	if *audio != "" {
+		stop, err := startAudio(*audio, *audioRate, *audioChannels)
+		if err != nil {
+			return err
+		}
+		defer stop()
+		properties = append(properties, "audio=1")
+	}
+
 	// Config.
 	config := ConfigMgr{}
 	config.ResetDefault()
@@ -18,9 +18,9 @@ func read(name string) []byte {
 
 var staticFiles = map[string]string{
 	"colorpicker.js":    "/**\n * ColorPicker - pure JavaScript color picker without using images, external CSS or 1px divs.\n * Copyright © 2011 David Durman, All rights reserved.\n */\n(function(window, document, undefined) {\n\n    var type = (window.SVGAngle || document.implementation.hasFeature(\"http://www.w3.org/TR/SVG11/feature#BasicStructure\", \"1.1\") ? \"SVG\" : \"VML\"),\n        picker, slide, hueOffset = 15, svgNS = 'http://www.w3.org/2000/svg';\n\n    // This HTML snippet is inserted into the innerHTML property of the passed color picker element\n    // when the no-hassle call to ColorPicker() is used, i.e. ColorPicker(function(hex, hsv, rgb) { ... });\n    \n    var colorpickerHTMLSnippet = [\n        \n        '<div class=\"picker-wrapper\">',\n                '<div class=\"picker\"></div>',\n                '<div class=\"picker-indicator\"></div>',\n        '</div>',\n        '<div class=\"slide-wrapper\">',\n                '<div class=\"slide\"></div>',\n                '<div class=\"slide-indicator\"></div>',\n        '</div>'\n        \n    ].join('');\n\n    /**\n     * Return mouse position relative to the element el.\n     */\n    function mousePosition(evt) {\n        // IE:\n        if (window.event && window.event.contentOverflow !== undefined) {\n            return { x: window.event.offsetX, y: window.event.offsetY };\n        }\n        // Webkit:\n        if (evt.offsetX !== undefined && evt.offsetY !== undefined) {\n            return { x: evt.offsetX, y: evt.offsetY };\n        }\n        // Firefox:\n        var wrapper = evt.target.parentNode.parentNode;\n        return { x: evt.layerX - wrapper.offsetLeft, y: evt.layerY - wrapper.offsetTop };\n    }\n\n    /**\n     * Create SVG element.\n     */\n    function $(el, attrs, children) {\n        el = document.createElementNS(svgNS, el);\n        for (var key in attrs)\n            el.setAttribute(key, attrs[key]);\n        if (Object.prototype.toString.call(children) != '[object Array]') children = [children];\n        var i = 0, len = (children[0] && children.length) || 0;\n        for (; i < len; i++)\n            el.appendChild(children[i]);\n        return el;\n    }\n\n    /**\n     * Create slide and picker markup depending on the supported technology.\n     */\n    if (type == 'SVG') {\n\n        slide = $('svg', { xmlns: 'http://www.w3.org/2000/svg', version: '1.1', width: '100%', height: '100%' },\n                  [\n                      $('defs', {},\n                        $('linearGradient', { id: 'gradient-hsv', x1: '0%', y1: '100%', x2: '0%', y2: '0%'},\n                          [\n                              $('stop', { offset: '0%', 'stop-color': '#FF0000', 'stop-opacity': '1' }),\n                              $('stop', { offset: '13%', 'stop-color': '#FF00FF', 'stop-opacity': '1' }),\n                              $('stop', { offset: '25%', 'stop-color': '#8000FF', 'stop-opacity': '1' }),\n                              $('stop', { offset: '38%', 'stop-color': '#0040FF', 'stop-opacity': '1' }),\n                              $('stop', { offset: '50%', 'stop-color': '#00FFFF', 'stop-opacity': '1' }),\n                              $('stop', { offset: '63%', 'stop-color': '#00FF40', 'stop-opacity': '1' }),\n                              $('stop', { offset: '75%', 'stop-color': '#0BED00', 'stop-opacity': '1' }),\n                              $('stop', { offset: '88%', 'stop-color': '#FFFF00', 'stop-opacity': '1' }),\n                              $('stop', { offset: '100%', 'stop-color': '#FF0000', 'stop-opacity': '1' })\n                          ]\n                         )\n                       ),\n                      $('rect', { x: '0', y: '0', width: '100%', height: '100%', fill: 'url(#gradient-hsv)'})\n                  ]\n                 );\n\n        picker = $('svg', { xmlns: 'http://www.w3.org/2000/svg', version: '1.1', width: '100%', height: '100%' },\n                   [\n                       $('defs', {},\n                         [\n                             $('linearGradient', { id: 'gradient-black', x1: '0%', y1: '100%', x2: '0%', y2: '0%'},\n                               [\n                                   $('stop', { offset: '0%', 'stop-color': '#000000', 'stop-opacity': '1' }),\n                                   $('stop', { offset: '100%', 'stop-color': '#CC9A81', 'stop-opacity': '0' })\n                               ]\n                              ),\n                             $('linearGradient', { id: 'gradient-white', x1: '0%', y1: '100%', x2: '100%', y2: '100%'},\n                               [\n                                   $('stop', { offset: '0%', 'stop-color': '#FFFFFF', 'stop-opacity': '1' }),\n                                   $('stop', { offset: '100%', 'stop-color': '#CC9A81', 'stop-opacity': '0' })\n                               ]\n                              )\n                         ]\n                        ),\n                       $('rect', { x: '0', y: '0', width: '100%', height: '100%', fill: 'url(#gradient-white)'}),\n                       $('rect', { x: '0', y: '0', width: '100%', height: '100%', fill: 'url(#gradient-black)'})\n                   ]\n                  );\n\n    } else if (type == 'VML') {\n        slide = [\n            '<DIV style=\"position: relative; width: 100%; height: 100%\">',\n            '<v:rect style=\"position: absolute; top: 0; left: 0; width: 100%; height: 100%\" stroked=\"f\" filled=\"t\">',\n            '<v:fill type=\"gradient\" method=\"none\" angle=\"0\" color=\"red\" color2=\"red\" colors=\"8519f fuchsia;.25 #8000ff;24903f #0040ff;.5 aqua;41287f #00ff40;.75 #0bed00;57671f yellow\"></v:fill>',\n            '</v:rect>',\n            '</DIV>'\n        ].join('');\n\n        picker = [\n            '<DIV style=\"position: relative; width: 100%; height: 100%\">',\n            '<v:rect style=\"position: absolute; left: -1px; top: -1px; width: 101%; height: 101%\" stroked=\"f\" filled=\"t\">',\n            '<v:fill type=\"gradient\" method=\"none\" angle=\"270\" color=\"#FFFFFF\" opacity=\"100%\" color2=\"#CC9A81\" o:opacity2=\"0%\"></v:fill>',\n            '</v:rect>',\n            '<v:rect style=\"position: absolute; left: 0px; top: 0px; width: 100%; height: 101%\" stroked=\"f\" filled=\"t\">',\n            '<v:fill type=\"gradient\" method=\"none\" angle=\"0\" color=\"#000000\" opacity=\"100%\" color2=\"#CC9A81\" o:opacity2=\"0%\"></v:fill>',\n            '</v:rect>',\n            '</DIV>'\n        ].join('');\n        \n        if (!document.namespaces['v'])\n            document.namespaces.add('v', 'urn:schemas-microsoft-com:vml', '#default#VML');\n    }\n\n    /**\n     * Convert HSV representation to RGB HEX string.\n     * Credits to http://www.raphaeljs.com\n     */\n    function hsv2rgb(hsv) {\n        var R, G, B, X, C;\n        var h = (hsv.h % 360) / 60;\n        \n        C = hsv.v * hsv.s;\n        X = C * (1 - Math.abs(h % 2 - 1));\n        R = G = B = hsv.v - C;\n\n        h = ~~h;\n        R += [C, X, 0, 0, X, C][h];\n        G += [X, C, C, X, 0, 0][h];\n        B += [0, 0, X, C, C, X][h];\n\n        var r = Math.floor(R * 255);\n        var g = Math.floor(G * 255);\n        var b = Math.floor(B * 255);\n        return { r: r, g: g, b: b, hex: \"#\" + (16777216 | b | (g << 8) | (r << 16)).toString(16).slice(1) };\n    }\n\n    /**\n     * Convert RGB representation to HSV.\n     * r, g, b can be either in <0,1> range or <0,255> range.\n     * Credits to http://www.raphaeljs.com\n     */\n    function rgb2hsv(rgb) {\n\n        var r = rgb.r;\n        var g = rgb.g;\n        var b = rgb.b;\n        \n        if (rgb.r > 1 || rgb.g > 1 || rgb.b > 1) {\n            r /= 255;\n            g /= 255;\n            b /= 255;\n        }\n\n        var H, S, V, C;\n        V = Math.max(r, g, b);\n        C = V - Math.min(r, g, b);\n        H = (C == 0 ? null :\n             V == r ? (g - b) / C + (g < b ? 6 : 0) :\n             V == g ? (b - r) / C + 2 :\n                      (r - g) / C + 4);\n        H = (H % 6) * 60;\n        S = C == 0 ? 0 : C / V;\n        return { h: H, s: S, v: V };\n    }\n\n    /**\n     * Return click event handler for the slider.\n     * Sets picker background color and calls ctx.callback if provided.\n     */  \n    function slideListener(ctx, slideElement, pickerElement) {\n        return function(evt) {\n            evt = evt || window.event;\n            var mouse = mousePosition(evt);\n            ctx.h = mouse.y / slideElement.offsetHeight * 360 + hueOffset;\n            var pickerColor = hsv2rgb({ h: ctx.h, s: 1, v: 1 });\n            var c = hsv2rgb({ h: ctx.h, s: ctx.s, v: ctx.v });\n            pickerElement.style.backgroundColor = pickerColor.hex;\n            ctx.callback && ctx.callback(c.hex, { h: ctx.h - hueOffset, s: ctx.s, v: ctx.v }, { r: c.r, g: c.g, b: c.b }, undefined, mouse);\n        }\n    };\n\n    /**\n     * Return click event handler for the picker.\n     * Calls ctx.callback if provided.\n     */  \n    function pickerListener(ctx, pickerElement) {\n        return function(evt) {\n            evt = evt || window.event;\n            var mouse = mousePosition(evt),\n                width = pickerElement.offsetWidth,            \n                height = pickerElement.offsetHeight;\n\n            ctx.s = mouse.x / width;\n            ctx.v = (height - mouse.y) / height;\n            var c = hsv2rgb(ctx);\n            ctx.callback && ctx.callback(c.hex, { h: ctx.h - hueOffset, s: ctx.s, v: ctx.v }, { r: c.r, g: c.g, b: c.b }, mouse);\n        }\n    };\n\n    var uniqID = 0;\n    \n    /**\n     * ColorPicker.\n     * @param {DOMElement} slideElement HSV slide element.\n     * @param {DOMElement} pickerElement HSV picker element.\n     * @param {Function} callback Called whenever the color is changed provided chosen color in RGB HEX format as the only argument.\n     */\n    function ColorPicker(slideElement, pickerElement, callback) {\n        \n        if (!(this instanceof ColorPicker)) return new ColorPicker(slideElement, pickerElement, callback);\n\n        this.h = 0;\n        this.s = 1;\n        this.v = 1;\n\n        if (!callback) {\n            // call of the form ColorPicker(element, funtion(hex, hsv, rgb) { ... }), i.e. the no-hassle call.\n\n            var element = slideElement;\n            element.innerHTML = colorpickerHTMLSnippet;\n            \n            this.slideElement = element.getElementsByClassName('slide')[0];\n            this.pickerElement = element.getElementsByClassName('picker')[0];\n            var slideIndicator = element.getElementsByClassName('slide-indicator')[0];\n            var pickerIndicator = element.getElementsByClassName('picker-indicator')[0];\n            \n            ColorPicker.fixIndicators(slideIndicator, pickerIndicator);\n\n            this.callback = function(hex, hsv, rgb, pickerCoordinate, slideCoordinate) {\n\n                ColorPicker.positionIndicators(slideIndicator, pickerIndicator, slideCoordinate, pickerCoordinate);\n                \n                pickerElement(hex, hsv, rgb);\n            };\n            \n        } else {\n        \n            this.callback = callback;\n            this.pickerElement = pickerElement;\n            this.slideElement = slideElement;\n        }\n\n        if (type == 'SVG') {\n\n            // Generate uniq IDs for linearGradients so that we don't have the same IDs within one document.\n            // Then reference those gradients in the associated rectangles.\n\n            var slideClone = slide.cloneNode(true);\n            var pickerClone = picker.cloneNode(true);\n            \n            var hsvGradient = slideClone.getElementById('gradient-hsv');\n            \n            var hsvRect = slideClone.getElementsByTagName('rect')[0];\n            \n            hsvGradient.id = 'gradient-hsv-' + uniqID;\n            hsvRect.setAttribute('fill', 'url(#' + hsvGradient.id + ')');\n\n            var blackAndWhiteGradients = [pickerClone.getElementById('gradient-black'), pickerClone.getElementById('gradient-white')];\n            var whiteAndBlackRects = pickerClone.getElementsByTagName('rect');\n            \n            blackAndWhiteGradients[0].id = 'gradient-black-' + uniqID;\n            blackAndWhiteGradients[1].id = 'gradient-white-' + uniqID;\n            \n            whiteAndBlackRects[0].setAttribute('fill', 'url(#' + blackAndWhiteGradients[1].id + ')');\n            whiteAndBlackRects[1].setAttribute('fill', 'url(#' + blackAndWhiteGradients[0].id + ')');\n\n            this.slideElement.appendChild(slideClone);\n            this.pickerElement.appendChild(pickerClone);\n\n            uniqID++;\n            \n        } else {\n            \n            this.slideElement.innerHTML = slide;\n            this.pickerElement.innerHTML = picker;            \n        }\n\n        addEventListener(this.slideElement, 'click', slideListener(this, this.slideElement, this.pickerElement));\n        addEventListener(this.pickerElement, 'click', pickerListener(this, this.pickerElement));\n\n        enableDragging(this, this.slideElement, slideListener(this, this.slideElement, this.pickerElement));\n        enableDragging(this, this.pickerElement, pickerListener(this, this.pickerElement));\n    };\n\n    function addEventListener(element, event, listener) {\n\n        if (element.attachEvent) {\n            \n            element.attachEvent('on' + event, listener);\n            \n        } else if (element.addEventListener) {\n\n            element.addEventListener(event, listener, false);\n        }\n    }\n\n   /**\n    * Enable drag&drop color selection.\n    * @param {object} ctx ColorPicker instance.\n    * @param {DOMElement} element HSV slide element or HSV picker element.\n    * @param {Function} listener Function that will be called whenever mouse is dragged over the element with event object as argument.\n    */\n    function enableDragging(ctx, element, listener) {\n        \n        var mousedown = false;\n\n        addEventListener(element, 'mousedown', function(evt) { mousedown = true;  });\n        addEventListener(element, 'mouseup',   function(evt) { mousedown = false;  });\n        addEventListener(element, 'mouseout',  function(evt) { mousedown = false;  });\n        addEventListener(element, 'mousemove', function(evt) {\n\n            if (mousedown) {\n                \n                listener(evt);\n            }\n        });\n    }\n\n\n    ColorPicker.hsv2rgb = function(hsv) {\n        var rgbHex = hsv2rgb(hsv);\n        delete rgbHex.hex;\n        return rgbHex;\n    };\n    \n    ColorPicker.hsv2hex = function(hsv) {\n        return hsv2rgb(hsv).hex;\n    };\n    \n    ColorPicker.rgb2hsv = rgb2hsv;\n\n    ColorPicker.rgb2hex = function(rgb) {\n        return hsv2rgb(rgb2hsv(rgb)).hex;\n    };\n    \n    ColorPicker.hex2hsv = function(hex) {\n        return rgb2hsv(ColorPicker.hex2rgb(hex));\n    };\n    \n    ColorPicker.hex2rgb = function(hex) {\n        return { r: parseInt(hex.substr(1, 2), 16), g: parseInt(hex.substr(3, 2), 16), b: parseInt(hex.substr(5, 2), 16) };\n    };\n\n    /**\n     * Sets color of the picker in hsv/rgb/hex format.\n     * @param {object} ctx ColorPicker instance.\n     * @param {object} hsv Object of the form: { h: <hue>, s: <saturation>, v: <value> }.\n     * @param {object} rgb Object of the form: { r: <red>, g: <green>, b: <blue> }.\n     * @param {string} hex String of the form: #RRGGBB.\n     */\n     function setColor(ctx, hsv, rgb, hex) {\n         ctx.h = hsv.h % 360;\n         ctx.s = hsv.s;\n         ctx.v = hsv.v;\n         \n         var c = hsv2rgb(ctx);\n         \n         var mouseSlide = {\n             y: (ctx.h * ctx.slideElement.offsetHeight) / 360,\n             x: 0    // not important\n         };\n         \n         var pickerHeight = ctx.pickerElement.offsetHeight;\n         \n         var mousePicker = {\n             x: ctx.s * ctx.pickerElement.offsetWidth,\n             y: pickerHeight - ctx.v * pickerHeight\n         };\n         \n         ctx.pickerElement.style.backgroundColor = hsv2rgb({ h: ctx.h, s: 1, v: 1 }).hex;\n         ctx.callback && ctx.callback(hex || c.hex, { h: ctx.h, s: ctx.s, v: ctx.v }, rgb || { r: c.r, g: c.g, b: c.b }, mousePicker, mouseSlide);\n         \n         return ctx;\n    };\n\n    /**\n     * Sets color of the picker in hsv format.\n     * @param {object} hsv Object of the form: { h: <hue>, s: <saturation>, v: <value> }.\n     */\n    ColorPicker.prototype.setHsv = function(hsv) {\n        return setColor(this, hsv);\n    };\n    \n    /**\n     * Sets color of the picker in rgb format.\n     * @param {object} rgb Object of the form: { r: <red>, g: <green>, b: <blue> }.\n     */\n    ColorPicker.prototype.setRgb = function(rgb) {\n        return setColor(this, rgb2hsv(rgb), rgb);\n    };\n\n    /**\n     * Sets color of the picker in hex format.\n     * @param {string} hex Hex color format #RRGGBB.\n     */\n    ColorPicker.prototype.setHex = function(hex) {\n        return setColor(this, ColorPicker.hex2hsv(hex), undefined, hex);\n    };\n\n    /**\n     * Helper to position indicators.\n     * @param {HTMLElement} slideIndicator DOM element representing the indicator of the slide area.\n     * @param {HTMLElement} pickerIndicator DOM element representing the indicator of the picker area.\n     * @param {object} mouseSlide Coordinates of the mouse cursor in the slide area.\n     * @param {object} mousePicker Coordinates of the mouse cursor in the picker area.\n     */\n    ColorPicker.positionIndicators = function(slideIndicator, pickerIndicator, mouseSlide, mousePicker) {\n        \n        if (mouseSlide) {\n            slideIndicator.style.top = (mouseSlide.y - slideIndicator.offsetHeight/2) + 'px';\n        }\n        if (mousePicker) {\n            pickerIndicator.style.top = (mousePicker.y - pickerIndicator.offsetHeight/2) + 'px';\n            pickerIndicator.style.left = (mousePicker.x - pickerIndicator.offsetWidth/2) + 'px';\n        } \n    };\n\n    /**\n     * Helper to fix indicators - this is recommended (and needed) for dragable color selection (see enabledDragging()).\n     */\n    ColorPicker.fixIndicators = function(slideIndicator, pickerIndicator) {\n\n        pickerIndicator.style.pointerEvents = 'none';\n        slideIndicator.style.pointerEvents = 'none';\n    };\n\n    window.ColorPicker = ColorPicker;\n\n})(window, window.document);\n",
-	"favicon.ico":       "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x80\x00\x00\x00\x80\b\x06\x00\x00\x00\xc3>a\xcb\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\x19tEXtSoftware\x00Adobe ImageReadyq\xc9e<\x00\x00\x18\x98IDATx\xda\xec]{PTW\x9a?\xa0\xcd\x1b\x1a\xb0\xe5\xa5<BP\x82` *Y\x8dF0O5\x13e3\xb3\x95ٍ\x89d\x92ɚڪ\t٭Tvj+\tS\xfe\x91\xdddgC\xb6j+\xa9TR\xc1MR\xb5\x9bd\xb2\xa8\x9b\x89\xe6\x05\x98\xa8IDE#\x88\x88\xdaH\x04A\x824 O\x85=\xbf\xcbm\xb6\xb9}\xce}t\xdf\xdb}\xbb\xf5\xab\xba\x85\xf6\xed\xbe\x8fs~\xdf\xfb;\xdf\t\x99\x9a\x9a\"z\xd3\xef\xdf\xff$\x9e\xfe)\x92|\xdc\xff\xf2\x96\x87\x1aI\x90\x13}\xf72\xf1\xddK\xe9\x91E\x8fL\x97\xd3\xed\xf4\xb0ӣ\x06\a\x1d\x0f\xbb\xbf\x9f7D/\x00\xd0\x17/\xa7\u007f\xca\xc4\x17\xb7\xca|\x15\x83P'\x1e\x18\x84\xfe \x98tLx\x05=\xb6j\xfci==*\xe9\x18\xd4\x05,\x00\xe8\xcbW\x88/\x9f\xe9\xe1%v\x8a@\xa8\x0e\xc0\x89\a\x87\xe3\xb9K\xbc\xbc\xd4\xeb\"\x10\xfa\x03\x06\x00\"\xea\xf1\xf2\x85:=K\xbb8\b\xd5\x012\xf9\x95\xf4\xcfK:^\xf2\x18\xa4\xa7\xafA\xe0\x11\x00\xc4ɯS\x10\xf5\xde\fD\x85?Ţ\n\xfb\xa6F\a\xaegJC\xfa\xdee\xa6\x06\x80\xc1\x93?K,\xd2\xc1\xa80\xa1\xae\xafQ\xab\xee\",\x96\xcb\xd1\x11\xe1\x03\xce\xff_\x19\x1d\x8b\x1b\x9d\x98HP\xf8ٳ\xf4\xbd\xabL\t\x00\x11\xfdv\xa5\xc9ϰ%v,\x9c\x970\x19\x1b\x15\x11\x97\x14\x17\x9bp\xe6\xe2%\x88wr\xba\xabg\ue941\xc1\x05\x1a\xa5A\x99\x19\xace\xb5\xc0O\x8e\x8f\xeb^}KN\xdcҌ\x05\x91\x11a\x16\xb7\xf3]\x97\x1d\xe4\xcb\xe3\xcd\xdd\xcd\x1d]ɜK8\xe0=\xf8J\x15h\x05@5\xcfҝ\x13\x1a:\xbc&/g\xa24?\xd7\xcazqWj\xee\xe8$?\xb4\xd9;N]\xb8\x98\xae\xe2\xb6\x0e\x11\x04u~\x9c|E\xe0c\xe2\x1f^]\x9c\x9c\x9a\xa0N0\x1ej\xb3\x8f|\xf2ݑH\u007fK\x01\xd5\x00\x109\xe0(\xeb\\|tT\xdfS\xf7\xaeML\x88\x89\xd2t\xf3\xd1\xf1\tR\xd7t\xca\xf1\xed\xc96˵\xc9I\xa5\x1f?\xee\x0f\x03Q\x9c\xfc:9cw㲥\xa3w.Y\x14\xa1\xf5\xda{\x8e\x9ep\xd47\xb5\xb2\x10s\x8c\xbek\x91/\xde/T\xc3w\x99\xfa82\xcc\xe2x恻5O\xbe\xa0#\xa9\xa4X\u007f[\x81\xf5\x1f6\xdd\x17\x95\x9d<\xbfW\xe1\xeb\xef\xd2ɨ\xf2\x83\x00\xa8\xe2M>\x95z#\xbf{\xe0n\xe2\xc9\xe4\x83\xf0\xee\xb8\x06\xe3T\xa1\b<S\x01\x80i\x9dn)Y\xa9(\xf2\x95\b\xe0\xf9\xed\xbdw\xda\x1eZ\xb9l\x04\xaaD\xe6\xabψj\xc8W\xdc_&\xa3\xf2F\xfenúH\xb5\"\x9fG\x85Y\v\xfb8\xa7\xcc#\x01Ā\x87\x95\xa5\xf7(\xe7\xea\xf60\xc59Y\x91tP\xa3\xacQ\x91r\xd2`\xab/@ r`\xb5\x91\x93\x0f\xcaOO\xe3\x19ťf\x92\x00Y\xac\x0f\x17\xa5&\xeb\xfe@\x18Ԋ_\xdccSP\t[}\xa0\x0e*yF߯\xd7\x14O\xe91\xf9\xd3\xd2/گ\xdeM\xa87?\xce[\x98\xa2\x88\x00Ǿ\x13\xe4\xdc\xefw\x90\x96\xbf\xf9Wr\xe6wo\x91\xde?\x1d ׆\xc7\x14m\x03\xa8\x84\x82\x8c\x05}\n\xea\xa0\xdc \xee\a\xe0\x9fa\x9d[\x91\x93\xd5K\x9fK\xd1\xe0\x19m\xefQ|O'\xe0\xfdIs\xbd\xf9\xf1σWF(\xa7\xf2\\\x19\xd2\xfd\x9f_\x93\xcb{\x8e\xcc\xfc\u007f\xa2w@\x00@\xdfg\x87\x89\xed\x97w\x90\xc4\r\xcbe\xaf\xff\xc8ڿH\xfc`\xdf\xf7}'\xce_H\x941\f\xed\x06\xb8\x88\xd5<\x83\xf7\x81eKmJ\x80\xef\xfd\xf8\x80\xf0\xae \x8b-\x8eD-I'\xa9\xdb6\x103\x92W\x12\xe0\xe2eG\x8f\xdcy\xd7\xc9w\xa5I\xca\x19=\xefՒ\xf3\xdb\xff[\x91K\x00\x02\x05IP#r\xac^\xdc\x0f\xdd\xcb\f\xf3R#\xd5\xc23x\xf1\x1e?\xfd\xb1\x86t\xbd\xb9gf\xf2\x9d\xa0w\xeck\x12\x80\xc1\x1c\xa3\xa1\xe1\x80\x00\x80\x9d)\x01\x86\xaex\x05\xa0\xe1\x93\x1d\xc4\xfe\x8f;\x04q\xe9\x05\b Ckt\x1c\x93r^\xa0\x87'\xfa1\xf9\xe7\xb7\xff\x17\x19:\xdcƽ\xe8ĥ\x016\x00\xae\\1?\x00x\xa1\xd8\xf6\x9e\x9fc\xbc}\x00p\b$\x81\x1a\x10P}\xc93\f\v\xc5\xec\x9c\x1e\xba\x9f\xe9\xf6m*.\xe4\xda;=TՍ\xb5_\xf2X\x8drN5\x9aI\x02\x80\xea\xdd\f\x9d\x89\x89\x04Ķy\x14\x9e\xa9\xceE\x9c\x148H\x19\x04Oݻ\xd6\x06=\xcc9\xfd\x92\x18\xad\xf4\x86*x\xdc\xcfsw\a\x1b\xda\x04\x11\xef)\xd9{zy\x92\xad\xdfl\x00`\x1aZHl\xf0~\xa0d\xe4i\x05\xc1\xb4w\xb0\xd6*\x13,\xf2\xd65,\xf7\x84\xfb\xd5Pxf\x12\xf3\xf3掮(\x8eԭ3\x1b\x00\xaa9/\x90\x8c\x98>S9\xaf-P-\x05\\A0q\xc9!\xeb6\xdd_\x94\xcf{\xee\x12O]C\xf1wn>\x19\x82R<\xee\x87a\xe7j\xf0ɺ\xb6\x8cq\x80\x01\xc8I\x0f\x1f3\x9d\x17 \xda\x01\xf5L\xd1\xd0t\x8a;cZ\xdd\x1f\x80\xe0\xa7\u007f\xab\x91\xf5\x0e\x10{\x97\xb1\a*=\x8c\xa33C\xddwߚǍ\xd4\xc0\xddS\xc7\xfd\xf3\x89e\xbe\xbb\xbf\xff\xf5\x89\x16\xde;Ԙ\x0e\x00r\"\x16\x19-\x9e;\x13AE_\xea\xb6\xf5\x9an\x02\x83JI\xb4>Z\xb2\x8a\xe7\x8fg\xf2t\xb9\x82\xf1\xb7Y\xfa9B\xbe\xc8\xeb{\xcb\xfd\x90\x84,:\xd1~aN@\x01\x80J\x01<X;\xeb\xdc[_\xec\xeb\x93\x1b\x00\x04~\xb4\x10\f+\x18X<B\x02\xa9$\u007f\xb1C\x8b1\xa7\x95\xfbsR\x93zy~?\x82Yj\xc9ZR\xc0P\x9d\x9d<\xf1\xdf\xee\xcb\xf2yO\xfcx\xa6\xbb\xd5\u007feX\x88\xda\xf1~\x04\x00X\xd7\xe6k\xbaQכ\x9fɪ\x02\x14\x9fpҩV\x8d\xb6\x00\xf3\xbb\xb7\xe7d1\vV\x86\x9b;T\xbb}x\xe79Q\xe1n\x9f\u007f~\x8ck<\xfb4\xe5\xad\x19\x00bQ\x06\xd3\x16@\xc8V\x0e\x04\xb0\a\xb4\x80@\x88\x18ʨ\x02p皼\x9cqo\xa4\x80h/\x14\xb2\xc4\xff\x92\xf44\xae\xf1\xa7\x96X\x92\xefl\xf7%\xd2\xdd?\x90\xac\xc5\xd86\x93\x04p\x0e\xae\xc3\x17 \x80*\x00\xc7y \x05\nU\xc6\x05\xb8\xe2\x9f\xf59$\x92Z\xbf?fy\x0e\xd3\xf8\xdb{\xb4\x89\xf7B;|]\x16\xee\x11\x00D\x1d\xc5\xe50\x80\x005oz\x81\xa0\xfb=y)p[v\xc6\x15-\xa2]\r\x00x\xe2\u007f\xa8\xe1\xb4\xea\xe7f\xc5A`,\x9f\xef\xedKע^\xcd(\x01\x9c\xaa\xe0q\xdey\x14<\xea\x05\x02\xe8[9\xb1{G\xee\xcd6-\x93+\x15\"ZĿZ\xe3/*/]\xc8\x02J\xe9\xbd\xfa\x83<\u05ef\xde\x1f\xd5\xcf^%sD\x10\xec\xf0\x06\x04\x10\x93jH\xce\xe7Fp\x88SE\x94)\x97)\x143\u007fn2zAb<s\x92\x10\xa0Rk\xfc\xf1t\u007f\xd7e\x87\xcd,\xdc\xef5\x00D\x10\x94{\x05\x82\xa77\xa8\x8a\x16N\xa7U\xf9R`i\xe6\xc2k\x1eH\x01\xe6\xb9\x159Y6#\xb8\u007fסc\xdd2\xdc_\x17\x90\x00\xf0\x16\x04p\x91 \tB\x19\xae\x92\x16)\xb0,;#Y\xad\x88W:\x97\x93\x92\x14\xc9\xd6\xffm\x1es?\xb5\x8b\x86e,\u007f\xbfp\xbfn\x00P\v\x02^\xe6p:Z\xb8\xc1+)\x005\xc0\xf1\x06J9\xe2?\x8b\xe5\xfe\xc5EFt\xb3Jܑ\xa4R\x13\xf9\xe3q?}\xff\t\xceOv\xfas\xd1K\xa8\x9e\x17\x13AP\xcf;\xff\x1f\x9fՎ\xf0@\x10\xbb\"\x87$\xac_\xe6\x95\x14\xe0\xe8n+\xc7\x0e`\x02c\xf1\x82\x14fx\xf6\xb2J\xf1\xcf\xe2\xfeo\x9aO\x8f\x8e\x8cOX\xbd\x89W\x04\x04\x00\\\xf4*3\x9bumr2R\x0e\x04ɏݥh\x0f\xc8I\x81\x8c\xf9\xf3\xc28?+R\v\x80\xdbnJg\xea\xffA\x15\xe2\x9f\xc5\xfdȔ\xeeml\xe2-\xbfz\xdd\xdf\xeb\x1eu\a\x80\x18\xc8(U\x02\x01/y\xa4F\x15\xf0\xa4\x80L\x95r\x91Z\x03\x90\x95\xfa\xc5\xe4O\xaa\xa8\xf0eq\xffG\a\x1b\xba\xf1ά\x18\x97?u\xbf\x91\x12@\x15\b\x90<b\xd5\x11\xc0\x1eHzt\x9d\xa2\x14`E\a\xd3\x12\xe2U\x19{b\x84\xd0M$cU\xb3\xa7\xc1\x1f\xb8\xb3R\xee\x87\xdb'\xb3\n\xb8\xca\f\xedqB\x8d\xba\xb0\x12\b\x90<z\xfdӯ\x98!cD\xd0 N\xe5\x88\xe5\x92!*\x885\xf9*$\x00\x93\xfb\xf3\xd2Sc<\x15\xffɏ\xb9\x83\xf6\xc3\xfd\r\xbc\xa0\x0f2~~\xe7~C\x01\xe0\x02\x822\xc2\xc9\x1b\xc8e\x10\x95j\bP\x81˪\x1cJ\xb2\xc6\x0eq\f\xc1x%\xfd_\x98\x99\x9e\xe0\x89\xf8GDS\x1a\xf3\x87\xdb\xeb\x18\x1e\xe1\x05}ʉI(\xd4\xe8\x1b\x88FN)\x91I\x1e\xc1J\x96~\x8e\x01U\xaa!`q&\x1aS\xa8\xb0\x03\xdc\xea\xfe!9X\ue7d2\xf8G\xfc\"\x89\x1a\xafR\xc3oס\xc6\x103\xba}>\a\x80\b\x82F\xb9\x80̟\x8f\xfc\x18\x01}\xc92\xaa\xb0\xb2\x86G,o\xe0\xe6\x94\xf9\x99rv\x80\x18\xfeu\xa3̤y,\xc9!\x9b\x89t\xaa+i\xbe\xff\xd3#?\xf6^\xbd6\x19\xc11\xfcL\xd5\xf6&\xd4W7\x12A\xc0M\x1e\xbd\xfb\xf5\xfeQ\x96Qh\xfb\x15_\n ./U\x03\xa9|C\xb0H\x9c|\xa6\xf8\xcdOO\xb3i\r\xfe\x00\x9c\t\x92\x8c\x1f\xbc\x9b\x866\xbbM\xc6\xf0\xb3_\x97\x00\x10APM\xa6{\xe2\xb9\x118\x86z\x06\xbd\xee\xfa\xb5@\xd6 \x94\xaa\x01\x99F\x15\xa8\xf9\xab%\x9c\x85\x1f\xac\xf0\xafR\xe8\x17\xe0\x94r\xff\xc7\a\x0fs\r?\xe2\xe3j\x1f\xd3\x01@\x04\x01D\xe0N\xd69d\xcaX\xf6\x80\x9c-\xc0R\x03\xf3bcڵ<\x13O\xff\x0f\xca\xe8\u007fp\xbf\xb4\xd8\x13j\x8c\x1e\xdcl\x9f\x19\xbb\xa2\x86\xfa\xe9\xbe\xe5\x84S\\\xba\xb7\xb1iR\x1a$\x82\u007f͓\x02\xac\xf4l\xe6\xfcDM\xab\x9e\v2\x17\xb8e\x12\x95R\xbf,դ\x90\xed\xab&&$\xbf\x00\xc0\xc5=d\x05\x89\xa2XE\x13I\x8f\xf1\x83C\xd2\xd5D2]7\x98tW\xc1-n\\\x8b\x85\xabr\x96\u007f̊En\xdco\xc6l\x9fY%\x80\xd3(\xfc\x03O\x15 }:KLg&1U\x01&#B\xb2\xec\n\xe1ܹs挩y\x0e\xf4%b\x89\u007f\xdej^\x9e\xe5/\xc3\xfd;\xcd\xda\xf5\x144ן7G4Ll\xc4䖖E\xfa\xb4 c6#\xc3\xe2v\xd4O/\xc8@\xd2\bj!V\u0089\x02X\xc2,\xe4\x9f~\xb91\xbc\xf3\xf2l\x95{\xbe\xb7\xef\xf2\xe0\xf0\xe8@\xb8Œ2/6:\x1c@\xa1\x93\x1f\xa9\x89c\xe8\xc4K-\u007f\x05\xee\xaf &&\xbf\x02@\x8c\xc9g\xb1\xce!}\x8a\xac\xa1k\v\x15p]\xc6\v\x0f\x93\xd0\xe8\bf\xad\xbd\x14\x04\xd2\xc4\x0e\xfd?\"}\tj\x9e\r\xd1=\x84\x9b\xa5Q@H!\xe9\xbd\xc5*_\x96\x91\xb2\xc3ln\x9fiT\x80\x9a֫#\xe3\xee%\xff\x88\x10\xceQQ=\xe4-\xe1>\v\xff\xbeL\x00\x82\xb3Z\t\x96\u007f\"\xc3\xef7S\x95o@H\x00\x97\x8e\xdb\xdc\xc9GG\x10ʱ\x89\xfe\x1c\x1c\xc1\xfb@\u007f\x1f2\x1d\x11dU\xfa|\u007f\xfa\xac\x83\xf3\x1e\xa6\xe7~\u007f\xaa\x00\xb8D̐-ʺ6\xdf^\x84\x9e\x81\x89f\x1a(\xd6䃎\x9e=?\xca\x01@5\t\x00\x9a\xeb\a\xeeG\f`3o\xf2\xf5j\xc0\xe8\v\x82\xf8\x1f\x18\x19e\x19\u007f\xc7\xccl\xf9\xfb\xcd\x06\x10E\u007fU0L\xbe\x00\x00~\x83\xa7\x9a@y\a_\x1b\x81\x95<\xbd\xff\x9b\xbbW\a\xd4\xe4+P\xdd\r\x00\xb8s?\xdc=f\xf7M\xac\xf3׳\xe7\xb0\t\xa8\xf4\x06\x00T\xbaD\xe8\xfa\x85\xb6\xe9\x818ˑa\xbc\"dvl\xe3\xba\x05\x80\xa8\xfb\x99\xb1\xff\r˖\x86\x05*\x9b˨\xac\xa2\x1b\x00\x98M\xe5\x84Ӂ\v-\xe2\x03Y\xd6sRυz\xb6\xaf\r\x16\x00\xb8\x91\\\a\xae@!\x99\xd4s\xe9\r\x00\x90\x99\x90\xaf[\xb2\aE\x18\x81\xce\xfd \x99\xd4s\xd9\r\x00\xc8\f\x04\xab\b#\x10\t\x8d$8\x8bR7\xfbjߟ\x80\x04\x80LW\x8f\x80#^?!b\xa2\xfa\u007f\xbf\x00\x80\xb7\x04\x1b\xe2?\x88\x82>\xdc~B\xc4\xe4\xb5\x00\xbe\x90\x00\xa5\xc1,\xfeU\xa8\x81L\xa3\xb6\xb5\t\x14\x000\xc5?o\tv \x93L\xbf\xc2\xca\x1b\x12\xc0\x85\xc0)A\x16\xf6\x9d~Q~\xbf\xc2L\xdej\xa4\xa0\x06\x80\xd6\x0e\\\x81N\n\xfd\n+\xaeG\t\xa0\xa9\x03W0\x10\xab\xbc\xdc\xc5%̺\x01\x00\xc2\xef\xc0\x15\f\x84\xf2\xf2ܴ\xe4N-\xe3\x11\x94\x00\x10ſ[\xc9\x17b\xff\x9el2\x1dP.ᢛ\xd2\x02)&`\x94\x04`\xbe\xec\xca\xdc\xec9$\xc8\t.a\xb8e.\xab)\x94)\x13Ds\r\xe0~\x84?\x99+pY\x1d8䨳\xb3\x93\xecڵK\xf8wZZ\x1aY\xb7n\x1d\x89\x8d\x8d5t@jkkɩS\xa7\xa6\xed\x95\x15+\x84C+\xe5-L\ri<\xd7\xc1S\x03UA\r\x00\x9e\xaeC\x03&*\xfe\xd3\xd5^\x04\x13\xff\xe2\x8b/\xce\xfa,55\x95TUU\x91\xdc\xdc\\\xdd\x1fzpp\x90TTT\x90Çg\xf7\x1ez\xf0\xc1\a\xc9\xf6\xed\xdb5]\xab8'\x8bp\x00Pj6\x00\x18\xa1\x02\x98>oI\xfebՓ\xdf\xd0\xd0\xe06\xf9\xa0\xae\xae.\xf2\xc2\v/\x182\x10\xaf\xbc\xf2\x8a\xdb\xe4\x83v\xef\xdeM\xde|\xf3MM\xd7B\x9ccNh\xa8걹.\x00\xc0k\xbf\u03a27\xdex\x83{\xae\xb5\xb5uF-\xe8EP5\x98h\x1e\xbd\xff\xfe\xfb\x9a\xaf\x99\x1c\xcflm\x83fU\xb7\x06;\x00ܬ\xff\xf9q\xb1\x17\xb4\\\x80ŉ\xaet\xe8\xd0!]\x1f\x18\x12G\x8e\x86\x86\x86\x14\xbf#\xa5\xd4xn\xb2뀙\xf2\x03\xba\x02\x80\x17\xf2\\\x94\x9atUo\x8e5\xf3\xf5\xa6%^*\xef\x14\xaa\xa0\x84m\xef\xcd\x10\"\xf6IIXJ\x825I\xcf\xeb\xe9\xed\t\x18\xe1Y\U000366d3H\xcaZ\n\x82\x1a\u007f\x16\x8e\xe8\r\x00\xa6\x9f;/6ZS\xf4o\xf1\xe2Ų\xe7\xef\xba\xeb.]\x1fZ\x8d\xab\xa7\xd5\xf3А\xf0\xc229\xbfI\x03\x9f\x00@+=\xfd\xf4\xd3\xdcs111B<@O\xc2\xe4._\xce\xdf\xe8\xfa\x91G\x1e1:\xfe`\x15\xa5Ay\xa0\x03@\x17\xc2\x04\xc3\xfffM\xfe;\xef\xbcc\xc8d \xbe\xc0\x92<\xf8l۶m\xbezu\xd8\x06վ\x1ck\xbd\x03A\xba\xb5AC\xf0e\xf3\xe6\xcdd\xe7Ν\x82\x91V\\\\l('\xe2\xba\x00\x17\\L\xa7\x97\x01U\xb3i\xd3&}EdҼɱ\x89\xab\xa1\xbc=\x13(m\xa5 h|y\xcbC>\t\x18\x85LMM\xe9\xed\x05\xd4J?G#\xa6`(\x01\xd7BX:\xfeJ\xcd\x1e\xb7\xcf\xef\xbe5\x8f\xdcC\x8f\xda\x13-c\x9f76˵:y\xdc\x17\xad\xe5|\xa2\x02\xec=\xbd}\xe4:#\x99\xa5\xe3\xd3j\xae\xe0\x96\xf0m\xf7\x97L\xc8x\vU*w>5\x0f\x00xM\x11ںz|҈\x02\xe2\x1b\xa1b\x9e_\x8f`Ϋ\xaf\xbe*\xc4\xfd\x8d&\x9e\x88Os\xa9\x86Μ?\xcf\xf2\xb7\xf7\x95\\\xe3\x80\x00_\fH\t\xe0\xb6A\x04\xbah\xf0\xb6\x88ѓ\x10\xcfGHw\xe3ƍ\xb3\x80\x80\f\xdf\x13O<A\x9e|\xf2I\xf2\xc1\a\x1f\xe8\x1eJf\x11\xab\xfb9(!f\xf6j\xb8\x94\xf8\xb89\x00\x01\xe72H!W\x06\x1a\x00\x98R\xe0\xeb\x13-\x86\xd7\x02\xba\x1a\x88N \x14\x15\x15\x91g\x9f}v&\xbcl\x84\x1b)%t=o\xee\xe8r\x0f\x0eY,\xcc\x15\xc5\x00\xc1\xc3k\x8ay\x8d-+\x8c\xac#0\x02\x00L\xb1u\xf4\xec\xf9(\xa3\xa5\x00\\9L\xb0R\x8c\x01\xb5\x05Fҷ-\xec.\xe3r\t\xb1\xa2\xac\xf4\xf0\xfc\x8c\xb41\x8e*0L\n\xe8\xea\x05\xb8x\x03h\x03\xeb\xb6\"(;y~\xefo\xef\xbd\xd3ТP\x88}d\x13\xa5\xd9=\x00\x03\xae\xa5/\xb8\xff_\xfeg\x0f\x19\x9dp\xdf\xfb\xe0ђ\x95\xb2 \xc0o\x84ߎ3\xf7\x98|\x80\x1eN\x0e\xb2\xebՂ\xce(/\xa0\x8a\xa3\x17mr\xfb\b\xebA\xe0nL\xf4\xdbo\xbf=\xeb\xf3-[\xb6\x18>\xf9\x82\xeai8Μ\xfc\xf8\xe8(Ŕ8T\xc4\xfdE\xf9\xbc\xf1\xf9Tt\xb1q\x9c\xa3L\xd6/\xe6\x11JM\a\x00\xd1\u007fe\xee\x16\xb6\xf3\x87\xc6)\x99 \x88jB\xd9֚5k\x04\xe3\x0eF\x1e\xeb\xbc+\xb5\xb4\xb4\xb8Y\xff\x90\x16\xa8\x02\x82\x9d\xa0\xb5\xe8\x83E\x87ϴ\x93#g\xd9[\x15\xac\xc9S\xb7K\xfa\xca\xc5ّ*\vg\xa1\x1a\x84M0(\b\xea<\xb5\x13\xe6TV\x1a\xa3^\xbe:~\xb2\x850\x8aC\xa9ʱ4\x9ci\x1f\xc9[\x98j\x89\x8d\x8c\xf0\xf8\xfa{\xf7\xee%uuuB\x95\x10\xfe\r\x030<<\x9c\xd8l6\xc1\x03x\xf7\xddwg\xc7\"\xecv\xb2\u007f\xff~\xe1;\x00\x02@\xf3\xfc\xf3\xcf\v\x05&N\x15\xb1~\xfdz\xaf&\xff\xe3\x83\xec:\x06p\xff_\xdfy\xbb\xeakER\xb7\x90eD\xca\x10&\xffq:\xe6{\xee\xb95\xef\xa2\xdfm\x00\x17[\x00\xaa\x80\xd9\x19\f˨~\xbd\xa6x\xaa c\x81Gu\xe2\xe0^X\xf9z\x903\xc7\xe0i\xad\xe1~j\xf4\xfd/\x15\xfd<\xa2v\x8f\x96젒- G\x10\xad\xa5b+~\xbf\xda\x00NUPAdv\x0f\xfd`\xdf\xf7Q{\x8e\x9e\xf0H\x1f@\xd7#7\xe0\xcf\xc9\xc7\x04}t\xe0\xb0\xec䯾%\x87h]\v\t[ ?\xdd#OEs\xf0\xc8\x17\xa1\xe0R\x1e\b@\xf5M\xad\xd6\u007f\xfe\xe4\xb3^O\xec\x82\xe7\x9e{N0\xf6x\xf5\x03\x00\xc87\xdf|C^{\xed5f\xba\x17\x19\xc7\x0f?\xfcУ\xc9\xc7\xf3\xbe\xf5\xc5>\xae\xce\aݔl#\xbfX\xe1Y\t\xe0\x92\x85l\x00P0\xfd\xcc\xdb\xe2\xd6%xTn\n\x15\xe0\xa2\n\xe2\xc5\x00Q\xa1\xdc\xf7nY\x98ڷiEa\xa2'\xab\x87`\xf49\x8d<\xd8\x03F\x94\x8e;\xb9\xfe\xcb\xe3'\x05\xb1/G\b\xf8<u\xefZ5\x95A\\\xfaÇ\xbb\xdd\xd4\xc0\xb2\xecL\xf2Ww,\x17\x92M\xd8\u007f\x19\xbb\xaf2~\x8a^\xc5E\xa6\x01\x80\x16\x10\x80\x16\xa7%\x93\xf5\xb7\x15\x103u\x11\xc1D \xc0\xb3\xffd\x1b\xd3͓N҃\x94\xf3\xbd\x99|\xd0{\xf5\xdfQc\xb0\xd3͠|\xfe/\xa7\x8dU\x80\xe0\x8f\xbb>\x1f\xc6>K\f\xf5\x1bb\x16\x15\xe0| \xe7f\xd2;\x94\xbe\xdb\xda\xd9M\xfe\xfdӯ\xc8˟\xfc\x99|\xd7z\xd6\x13cH7\xc2 \x83\xe3a\x94Q+[q\xf2\x91\xee\x05\x87z;\xf9\x82D\\\x90\xe2\xf6\x19\xe5\xf8\x99\xf1\x80\xa4\xccIM\xfa\x99\xc3p\xaa\xe2\x03>m\x17/\x82\xa0\x1c~\xab\x18,\x92e\xf1\x81\xe1Q\xc4\r\x84\x83\xea\xd3)j\x18\x85\xc0\xa02Z2`\x80\x9b(\xe75\xffԩ\xda\x1d\x83ᆉ_\x92\xae_\x989\x85\xbd\xb6\x80`/$\xa7a\x89\x1dOO]\xb8\xe8\xf1=\xfc\xb2a\x04\x02E\x88b\x89\x16\xebf5\xbf9\xd7\xdd\x1bB\x8f\x99\xc1\xceN\xb1\t\xdb\xc4fSC+!:\x9ax\xb3\xea\x18\\\xdeE\a\xb5\x93\x1av\x10\xb9Z\rR\x18{\x8f\x95\xac҅\xeb])\xdd\xc6\xde3\xe3,\x1d\a\xbd\xba\xac\xf8m\xd3(\xe7ށ\xa2\xa8B4\xaaD\x8b\x9f\f\xce\xc4\xf1\x95\xc4\xf0\xc2$ \x90\"\xb3\x87\xb08\x88\x97\x9c\xc0\xf2\xf8\x1d\xa0\x8f\xa1\xeb\xc1\xf5\x8e\xe1\x91\xc9O\x0f\xffx\xb5\xe1\x8c]\xe8}\x8cb\x0f\xe4\xfb\xbd\x1d'\xbc\x93\x14\x90\xaeI5*\xa9\xf0\x02\xe9\x01\a\x00\x17 @\x1d\x94z\x02\x04\x96k\xe6$\x8d\x914\xcd~\xfa\xea\xbc\x1c\xb2\x86\xfa\xf8\x00\\\xed\x89Sß76A\x04\t\x93\x8fϨ\xf8\xd6elY\x1dɝ\xd5F\x00B[W\xcf<\x99q5?\x00\x18@Ȣ\u007f\xb12\xf4qb2\x02\xc7#\xa6\xbf\x9cZ\xf9Nq\xff\xa7\x83G\xc6)\xd7\xcf\xd2?k\x97,\x1e\x0e\xb7Xt鄑\xbb ٭\xb8\x046\n\x0e\xb8\x81\xd4\x03`鉝\x01#\x01\x18@\xb0\xd3?\xbf\xa1@0\r\x00\xe0\xd6姧\xba\x19x\xd4C\x19\x96N~Zb\xfcЪ\xdc\xec\x18\xbd\xee=q\xf5\x1aSҽR\xb3\xc712>\xc1\xdbX\xab&`\x01\xe0\x1a̐\xc6\f\xa8\xe13\x1e\x1167\xac\xb3\xcf!\xb8CFr:\x8c,֤\xbb\xda!_46\x0f\xd1\u007f:\x010B\xa5B\xe4\xa3%+c\xa0\"\x8c&l\xac\xc9\x1b7-\xd5\xc4f\x06\x00k\x8dAأ\xd4\xdav\x8aA\xb8C\xe0\x06\xe8B\xfc\x1b\x9fi\xb1\xe0\x85\x12\xadD\xeb\x8cш\x82M\xfcU\xe3Q \xfb7<>\xee\xba\xe6q\n\xe5\xde\x00\x8f\x1f\t/_\x1ePF\xa0\f\xd9\xe5\fB\xe7ְr\xee\x10\xaf0S\x0f\x17\n\x00p\x1dx\xfa<\xd6\xe57g\xfa{\xcc*\xb4d\x02\x03\x01\x00L\xebW-\x19Ց\x14\xe2_\"iF齬\xbe\x10\xfd\x1c\x02\x1a˴N\xbe\xd9\x01\xc0\f\xd8h%d먾\xa4\xe2=^\b\xd8\xe8A\xc8\aP\x82\xff\xed\xbc`r\x9aA\xd1\xc9Ny\x95\x86\x93\x88\xa8V\x89q\x15\x12\xd4\x00\xd0Jo\u007f\xf9\xed\xe0\x99\x8b=3\xb5\xe2\x10ѿZ\xb5\xdc\xe3\xeb\x8dMLL\x1dl=;\xf8\xe5\xf1\x93\x16\x97\xc97T\xda B\xc91\x90+\xf4\u061d\xd4\xcc\x00\xc0˽\xe4\xcd\x05\xa6\xa6\xa60\xf9g\xe8q\xb3SoÇ\xd7*\t\x10\xe5\xfb\xe2XshSGg\b54\xa5\x01\xfa^)\x18t\xb3\xe8\xe8}\xa9\xd4c%\xec\xaa\xf5ښ\xd6\xcc\x00Ыk\x06&\x1f\x95\xb6\x1e-N\x85\n\xf9\xe8\xc0a^\xd6\x14\x85\x19\xe9\b\xd7\u009bЛN\xfe\xd45\xc6y\xee:\xbd\xeeaf\x00x\xbd0rd|\xa6\x85?\x06\xb1\x1b\xbaڃ\xc9gN\xb8X\xb2\x95\x0eo\x04\xaaEo\x03\x10\xeaf_s+k\xf2\x1d\x9e\x18{\x81\b\x80,\xe9\aZ\xd3\xc0\x12K=Y\xeb\x03\xec\x96\xd4\xfa\xd1\xfb\xa7?V\xba\xca'\xbe\xfe\x97\xc7[\xaeQ\xf1Ϛ\x9fj=\xef\x13\x1aH\x00\x90.\xac\xf4D(\xa8\xfd\"\xb2\x84.\x85(\xe8rJ\x9e\xbao\xadO&\xff\xbbֳ#ߞ<\xcdcN]\x1bG\x98Y\x02\xb8\x05\x812l\x89`io\x94m\x0f\xd5՞Dk&Q\xddk\xb4\x9f\x8f\xf8\xc2\xc7\a\x0f\x8f5\x9d\xef\xe4\xd9+\xaf\xeb\xb5$\xec\xbap\x03\x19\x14\xe7\xe1$F\xa4%\x18\xd7\xc9\r\xd6\xfe\x81\x963\xa3?\xb4\x9d\x8b\xa2R'\\&\xd8S\xa9\xf7\xbd\x03\n\x00)\xf1q\xaa\x97\x12\xd9{z'\xa5*\x8e\xfa\xea\t\x1e\xde:\x16Qțt\xf2\xf6`\xe0\xd9/\xf5\x8d\x9e\xbdxi\xeatWw\x14\xb5U\xf0\x9cQ\n\x01\x9f2O\x83=A\x03\x00:PWs\x17\xa4\x84\xabr\xa1.\\D\xdc8v\xb6\r\xa1^\u007f\xb7L\xff\xdeitD5\xff\xd45\xbe,;S\xf3N\xe7\xdd\xfd\x03W\xfb\x87G&.^v\x8c\x9f\xef\xed\xb3\"\xb0C\x8d\xbb\x10\rn\xa9\xe6\xd5>\xc1\x02\x00\x88\xbcY\xfa\x9a\x8a\xc8\xe8U\xb9\xd9S\xe1\x16\x8bl\xc9\xf3\xc5\xfe\x81k\xfb\x9aZ\xddډ\xa9\r%\xe3\xf7\xb8\x97\xebgT/\x87a\xedߊ\x9b\xb3\xae\x86\x86\x84̴\xbe\xed\x1f\x1e\xbeJ\xaf+$\xed{\a\x86\"\xfb\x86\xae\b qf'\xc51\x9e\xeba\x1c\x02\x11\xbfr\xa3&\x1f\xe4\xb3u\x01ZI엷\x95\xe5\nn]wǤ5*\x92\xe9\xc1\xb4_\xfay\xbc\xba\xf6@\x18\xa7\x94\xbc\xbb8'+f\xe3\xf2\xa5\xd1,[\x005\x06\x8d\xe7:\xae\xd47\xb7F\xfb\xb3\x14]\xe4z\xc4\xf7+\x8d\xbe\x91\x99\x01PJ\x18-眄B\r\xd1+\x10hht,\xaa\xa9\xe3\x82E-\x97\xa7%ƏE\x87\x87\x8b:u*\xacwp(\xc1\x17}\x8cTH=\xb8y\xd5F\xe8\xfb\x80\x02\x80\x9c\x14\b2\xaa'ӡ\xdd\x1a#E}\xa0\x1a\x81X]\x8c\x90pa\x90L6tz\xa3\xf3\xd0+\xa1\x13\xb4\x12@\x94\x02p\xc0k\x88\x17\xe5\xe2^\xea\xe2J\xf1\xfee\xe2\x01@Z9\x9c<ㅊG\xbf8\xd9\xfd\xfe\xe0\xee\xa0\x00\x80\v\x10*\xc4\xc9P\x1b\t\xdc)~\xbfT\xe3\uf7045\x8c\x95zG\xden\x00\xc0{ 891K\"\x15\xdaEns\xeaS;\xe3w\xa5\xe4\xff\xb3\x8c\xae\x9c|̅[\x1b\xc5\xdf\xf7\x93\xeb\x80\xfeO\x80\x01\x00\x908\xba\x06Ⱦ\xbc\xbf\x00\x00\x00\x00IEND\xaeB`\x82",
-	"root.html":         "<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n  <meta http-equiv=\"Content-type\" content=\"text/html; charset=UTF-8\">\n  <meta name=\"viewport\" content=\"width=device-width, initial-scale=1, maximum-scale=1, user-scalable=yes\" />\n  <meta http-equiv=\"X-UA-Compatible\" content=\"IE=edge\">\n  <meta name=\"apple-mobile-web-app-capable\" content=\"yes\">\n  <meta name=\"mobile-web-app-capable\" content=\"yes\">\n\n  <title>dlibox - {{.Host}}</title>\n\n  <link rel=\"stylesheet\" href=\"https://maxcdn.bootstrapcdn.com/bootstrap/3.3.6/css/bootstrap.min.css\" integrity=\"sha384-1q8mTJOASx8j1Au+a5WDVnPi2lkFfwwEAa8hDDdjZlpLegxhjVME1fgjWPGmkzs7\" crossorigin=\"anonymous\">\n  <link rel=\"stylesheet\" href=\"https://maxcdn.bootstrapcdn.com/bootstrap/3.3.6/css/bootstrap-theme.min.css\" integrity=\"sha384-fLW2N01lMqjakBkx3l/M9EahuwpSfeNvV63J5ezn3uZzapT0u7EYsXMjQV+0En5r\" crossorigin=\"anonymous\">\n  <script src=\"https://ajax.googleapis.com/ajax/libs/jquery/2.2.1/jquery.min.js\"></script>\n  <script src=\"https://maxcdn.bootstrapcdn.com/bootstrap/3.3.6/js/bootstrap.min.js\" integrity=\"sha384-0mSbJDEHialfmuBBQP6A4Qrprq5OVfW37PRR3j5ELqxss1yVqOtnepnHVP9aJ7xS\" crossorigin=\"anonymous\"></script>\n\n  <!--\n  https://github.com/DavidDurman/FlexiColorPicker\n  -->\n  <script type=\"text/javascript\" src=\"/static/colorpicker.js\"></script>\n  <!--\n  <link rel=\"stylesheet\" href=\"/src/themes.css\">\n  -->\n\n  <style>\n    #background {\n      position: absolute;\n      top: 0;\n      left: 0;\n      bottom: 0;\n      right: 0;\n      z-index: -1;\n      overflow: hidden;\n      color: #dddddd;\n      font-size: 128px;\n    }\n\n    #picker-wrapper {\n        width: 200px;\n        height: 200px;\n        position: relative;\n    }\n    #slider-wrapper {\n        width: 30px;\n        height: 200px;\n        position: relative;\n    }\n    #picker-indicator {\n        width: 3px;\n        height: 3px;\n        position: absolute;\n        border: 1px solid white;\n    }\n    #slider-indicator {\n        width: 100%;\n        height: 10px;\n        position: absolute;\n        border: 1px solid black;\n    }\n/*\n    #picker {\n      height: 200px;\n      width: 200px;\n    }\n    #slider {\n      height: 200px;\n      width: 30px;\n    }\n*/\n  </style>\n  <script>\n    \"use strict\";\n\n    var patterns = {\n      \"Aurores\": \"{\\\"_type\\\":\\\"Aurore\\\"}\",\n      \"Canne de Noël\": \"{\\\"MovesPerSec\\\":6,\\\"Child\\\":{\\\"Frame\\\":\\\"Lff0000ff0000ff0000ff0000ff0000ffffffffffffffffffffffffffffff\\\",\\\"_type\\\":\\\"Repeated\\\"},\\\"_type\\\":\\\"Rotate\\\"}\",\n      \"Ciel étoilé\": \"{\\\"Patterns\\\":[{\\\"_type\\\":\\\"Aurore\\\"},{\\\"Seed\\\":0,\\\"Stars\\\":null,\\\"_type\\\":\\\"NightStars\\\"},{\\\"AverageDelay\\\":0,\\\"Duration\\\":0,\\\"_type\\\":\\\"WishingStar\\\"}],\\\"Weights\\\":[1,1,1],\\\"_type\\\":\\\"Mixer\\\"}\",\n      \"Cycle RGB\": \"{\\\"DurationShowMS\\\":1000000,\\\"DurationTransitionMS\\\":1000000,\\\"Patterns\\\":[\\\"#ff0000\\\",\\\"#00ff00\\\",\\\"#0000ff\\\"],\\\"Transition\\\":\\\"easeinout\\\",\\\"_type\\\":\\\"Loop\\\"}\",\n      \"Dégradé bleu\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#0000ff\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"Dégradé rouge\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#ff0000\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"Dégradé vert\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#00ff00\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"Dégradé\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#ffffff\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"K2000\": \"{\\\"Child\\\":\\\"Lff0000ff0000ee0000dd0000cc0000bb0000aa0000990000880000770000660000550000440000330000220000110000\\\",\\\"MovesPerSec\\\":30,\\\"_type\\\":\\\"PingPong\\\"}\",\n      \"Morning alarm\":\n        \"{\\\"Duration\\\":600000000000,\\\"After\\\":\\\"#000000\\\",\\\"Offset\\\":1800000000000,\\\"Before\\\":{\\\"Duration\\\":600000000000,\\\"After\\\":\\\"#ffffff\\\",\\\"Offset\\\":600000000000,\\\"Before\\\":{\\\"Duration\\\":600000000000,\\\"After\\\":\\\"#ff7f00\\\",\\\"Offset\\\":0,\\\"Before\\\":\\\"#000000\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Transition\\\"},\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Transition\\\"},\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Transition\\\"}\",\n      \"Noir\": \"\\\"#000000\\\"\",\n      \"Ping pong\": \"{\\\"Child\\\":\\\"Lffffff\\\",\\\"MovesPerSec\\\":30,\\\"_type\\\":\\\"PingPong\\\"}\",\n      \"Rainbow cycle\": \"{\\\"DurationShowMS\\\":1000000,\\\"DurationTransitionMS\\\":10000000,\\\"Patterns\\\":[\\\"#ff0000\\\",\\\"#ff7f00\\\",\\\"#ffff00\\\",\\\"#00ff00\\\",\\\"#0000ff\\\",\\\"#4b0082\\\",\\\"#8b00ff\\\"],\\\"Transition\\\":\\\"easeinout\\\",\\\"_type\\\":\\\"Loop\\\"}\",\n      \"Rainbow static\": \"\\\"Rainbow\\\"\",\n      \"Étoiles cintillantes\": \"{\\\"Seed\\\":0,\\\"Stars\\\":null,\\\"_type\\\":\\\"NightStars\\\"}\",\n    };\n\n\n    function onload() {\n      // Set background.\n      var text = '';\n      for (var i=0; i<50; i++) {\n        text += '🐉🐢🐇🌴';\n      }\n      document.getElementById('background').innerText = text;\n      loadButtons();\n\n      // http://www.daviddurman.com/flexi-color-picker/\n      ColorPicker.fixIndicators(\n          document.getElementById('slider-indicator'),\n          document.getElementById('picker-indicator'));\n      ColorPicker(\n          document.getElementById('slider'),\n          document.getElementById('picker'),\n          //document.getElementById('fancy'),\n          function(hex, hsv, rgb, pickerCoordinate, sliderCoordinate) {\n            ColorPicker.positionIndicators(\n                document.getElementById('slider-indicator'),\n                document.getElementById('picker-indicator'),\n                sliderCoordinate, pickerCoordinate);\n            document.body.style.backgroundColor = hex;\n            document.getElementById('rgb_r').value = rgb.r;\n            document.getElementById('rgb_g').value = rgb.g;\n            document.getElementById('rgb_b').value = rgb.b;\n            updatePattern('\"' + hex + '\"');\n          });\n    }\n\n    function loadButtons() {\n      // Create buttons.\n      var styles = [\n        \"btn-primary\", \"btn-info\", \"btn-warning\", \"btn-danger\", \"btn-success\",\n        \"btn-default\",\n      ];\n      var j = 0;\n      var dst = document.getElementById('boutons');\n      for (var k in patterns) {\n        var node = document.createElement('button');\n        node.type = 'button';\n        var v = patterns[k];\n        node.id = 'button-' + k;\n        node.className = 'btn btn-lg ' + styles[j];\n        node.attributes['data-mode'] = v;\n        node.innerHTML = '<img height=5px src=\"/thumbnail/' + encodeURI(btoa(v)) + '\" /> ' + k;\n        node.addEventListener('click', function (event) {\n          updatePattern(this.attributes['data-mode']);\n        });\n        dst.appendChild(node);\n        dst.appendChild(document.createElement('br'));\n        j = (j + 1) % styles.length;\n      }\n    }\n\n    function updatePattern(data) {\n      document.getElementById(\"pattern\").value = data;\n      setPattern();\n    }\n\n    function setPattern() {\n      document.getElementById(\"pattern\").value = JSON.stringify(\n          JSON.parse(document.getElementById(\"pattern\").value), null, 2);\n      var oReq = new XMLHttpRequest();\n      oReq.open('post', '/switch', true);\n      oReq.setRequestHeader('Content-type', 'application/x-www-form-urlencoded');\n      oReq.send(\"pattern=\" + btoa(JSON.stringify(JSON.parse(document.getElementById(\"pattern\").value))));\n      return false;\n    }\n\n    function patternKeyDown() {\n      if (event.keyCode == 13) {\n        setPattern();\n      }\n      return false;\n    }\n  </script>\n</head>\n<body role=\"document\" onload=\"onload()\">\n  <div id=\"background\"></div>\n\n  <h1>dlibox - {{.Host}}</h1>\n  <!--\n    - Button with thumbnail in it. Use nearestneiborhood resize?\n    - Checkbox to add it to the button based loop. Changing takes effect\n      immediately.\n    - Settings:\n      - Nombre de LEDs.\n      - Upload\n      - Draw\n      - Delete\n      - Alarm: HH:MM / Jours (DLMMJVS)\n      - Wake up: 20 / 40 min\n      - Bonne nuit: 20 min.\n  -->\n  <div class=\"page-header\"><h2>Choix d'animations</h2></div>\n  <div id=\"boutons\"></div>\n\n\n  <div class=\"page-header\"><h2>Custom</h2></div>\n  <textarea id=\"pattern\" name=\"pattern\" cols=80 rows=10>\"#000000\"</textarea>\n  <input type=button value=\"Set\" onclick=\"setPattern()\" />\n\n  <div class=\"page-header\"><h2>Couleur manuelle</h2></div>\n\n  <!--\n  <div id=\"picker\"></div> <div id=\"slider\"></div>\n  -->\n  <div style=\"display:inline\">\n    <div id=\"picker-wrapper\">\n      <div id=\"picker\"></div>\n      <div id=\"picker-indicator\"></div>\n    </div>\n    <div id=\"slider-wrapper\">\n      <div id=\"slider\"></div>\n      <div id=\"slider-indicator\"></div>\n    </div>\n    <!--\n    <div id=\"fancy\" class=\"cp cp-fancy\"></div>\n    -->\n\n    <span id=\"rgb_css\"></span>\n    <label>R:</label>\n    <input id=\"rgb_r\" type=\"number\" value=\"\" />\n    <label>G:</label>\n    <input id=\"rgb_g\" type=\"number\" value=\"\" />\n    <label>B:</label>\n    <input id=\"rgb_b\" type=\"number\" value=\"\" />\n  </div>\n  <br>\n  <h2>Configuration</h2>\n  TODO(maruel): Add alarms here.\n  <p>\n\n</body>\n</html>\n",
+	"favicon.ico":       "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x80\x00\x00\x00\x80\b\x06\x00\x00\x00\xc3>a\xcb\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\x19tEXtSoftware\x00Adobe ImageReadyq\xc9e<\x00\x00\x18\x98IDATx\xda\xec]{PTW\x9a?\xa0\xcd\x1b\x1a\xb0\xe5\xa5<BP\x82` *Y\x8dF0O5\x13e3\xb3\x95ٍ\x89d\x92ɚڪ\t٭Tvj+\tS\xfe\x91\xdddgC\xb6j+\xa9TR\xc1MR\xb5\x9bd\xb2\xa8\x9b\x89\xe6\x05\x98\xa8IDE#\x88\x88\xdaH\x04A\x824 O\x85=\xbf\xcbm\xb6\xb9}\xce}t\xdf\xdb}\xbb\xf5\xab\xba\x85\xf6\xed\xbe\x8fs~\xdf\xfb;\xdf\t\x99\x9a\x9a\"z\xd3\xef\xdf\xff$\x9e\xfe)\x92|\xdc\xff\xf2\x96\x87\x1aI\x90\x13}\xf72\xf1\xddK\xe9\x91E\x8fL\x97\xd3\xed\xf4\xb0ӣ\x06\a\x1d\x0f\xbb\xbf\x9f7D/\x00\xd0\x17/\xa7\x7f\xca\xc4\x17\xb7\xca|\x15\x83P'\x1e\x18\x84\xfe \x98tLx\x05=\xb6j\xfci==*\xe9\x18\xd4\x05,\x00\xe8\xcbW\x88/\x9f\xe9\xe1%v\x8a@\xa8\x0e\xc0\x89\a\x87\xe3\xb9K\xbc\xbc\xd4\xeb\"\x10\xfa\x03\x06\x00\"\xea\xf1\xf2\x85:=K\xbb8\b\xd5\x012\xf9\x95\xf4\xcfK:^\xf2\x18\xa4\xa7\xafA\xe0\x11\x00\xc4ɯS\x10\xf5\xde\fD\x85?Ţ\n\xfb\xa6F\a\xaegJC\xfa\xdee\xa6\x06\x80\xc1\x93?K,\xd2\xc1\xa80\xa1\xae\xafQ\xab\xee\",\x96\xcb\xd1\x11\xe1\x03\xce\xff_\x19\x1d\x8b\x1b\x9d\x98HP\xf8ٳ\xf4\xbd\xabL\t\x00\x11\xfdv\xa5\xc9ϰ%v,\x9c\x970\x19\x1b\x15\x11\x97\x14\x17\x9bp\xe6\xe2%\x88wr\xba\xabg\ue941\xc1\x05\x1a\xa5A\x99\x19\xace\xb5\xc0O\x8e\x8f\xeb^}KN\xdcҌ\x05\x91\x11a\x16\xb7\xf3]\x97\x1d\xe4\xcb\xe3\xcd\xdd\xcd\x1d]ɜK8\xe0=\xf8J\x15h\x05@5\xcfҝ\x13\x1a:\xbc&/g\xa24?\xd7\xcazqWj\xee\xe8$?\xb4\xd9;N]\xb8\x98\xae\xe2\xb6\x0e\x11\x04u~\x9c|E\xe0c\xe2\x1f^]\x9c\x9c\x9a\xa0N0\x1ej\xb3\x8f|\xf2ݑH\x7fK\x01\xd5\x00\x109\xe0(\xeb\\|tT\xdfS\xf7\xaeML\x88\x89\xd2t\xf3\xd1\xf1\tR\xd7t\xca\xf1\xed\xc96˵\xc9I\xa5\x1f?\xee\x0f\x03Q\x9c\xfc:9cw㲥\xa3w.Y\x14\xa1\xf5\xda{\x8e\x9ep\xd47\xb5\xb2\x10s\x8c\xbek\x91/\xde/T\xc3w\x99\xfa82\xcc\xe2x恻5O\xbe\xa0#\xa9\xa4X\x7f[\x81\xf5\x1f6\xdd\x17\x95\x9d<\xbfW\xe1\xeb\xef\xd2ɨ\xf2\x83\x00\xa8\xe2M>\x95z#\xbf{\xe0n\xe2\xc9\xe4\x83\xf0\xee\xb8\x06\xe3T\xa1\b<S\x01\x80i\x9dn)Y\xa9(\xf2\x95\b\xe0\xf9\xed\xbdw\xda\x1eZ\xb9l\x04\xaaD\xe6\xabψj\xc8W\xdc_&\xa3\xf2F\xfenúH\xb5\"\x9fG\x85Y\v\xfb8\xa7\xcc#\x01Ā\x87\x95\xa5\xf7(\xe7\xea\xf60\xc59Y\x91tP\xa3\xacQ\x91r\xd2`\xab/@ r`\xb5\x91\x93\x0f\xcaOO\xe3\x19ťf\x92\x00Y\xac\x0f\x17\xa5&\xeb\xfe@\x18Ԋ_\xdccSP\t[}\xa0\x0e*yF߯\xd7\x14O\xe91\xf9\xd3\xd2/گ\xdeM\xa87?\xce[\x98\xa2\x88\x00Ǿ\x13\xe4\xdc\xefw\x90\x96\xbf\xf9Wr\xe6wo\x91\xde?\x1d ׆\xc7\x14m\x03\xa8\x84\x82\x8c\x05}\n\xea\xa0\xdc \xee\a\xe0\x9fa\x9d[\x91\x93\xd5K\x9fK\xd1\xe0\x19m\xefQ|O'\xe0\xfdIs\xbd\xf9\xf1σWF(\xa7\xf2\\\x19\xd2\xfd\x9f_\x93\xcb{\x8e\xcc\xfc\x7f\xa2w@\x00@\xdfg\x87\x89\xed\x97w\x90\xc4\r\xcbe\xaf\xff\xc8ڿH\xfc`\xdf\xf7}'\xce_H\x941\f\xed\x06\xb8\x88\xd5<\x83\xf7\x81eKmJ\x80\xef\xfd\xf8\x80\xf0\xae \x8b-\x8eD-I'\xa9\xdb6\x103\x92W\x12\xe0\xe2eG\x8f\xdcy\xd7\xc9w\xa5I\xca\x19=\xefՒ\xf3\xdb\xff[\x91K\x00\x02\x05IP#r\xac^\xdc\x0f\xdd\xcb\f\xf3R#\xd5\xc23x\xf1\x1e?\xfd\xb1\x86t\xbd\xb9gf\xf2\x9d\xa0w\xeck\x12\x80\xc1\x1c\xa3\xa1\xe1\x80\x00\x80\x9d)\x01\x86\xaex\x05\xa0\xe1\x93\x1d\xc4\xfe\x8f;\x04q\xe9\x05\b Ckt\x1c\x93r^\xa0\x87'\xfa1\xf9\xe7\xb7\xff\x17\x19:\xdcƽ\xe8ĥ\x016\x00\xae\\1?\x00x\xa1\xd8\xf6\x9e\x9fc\xbc}\x00p\b$\x81\x1a\x10P}\xc93\f\v\xc5\xec\x9c\x1e\xba\x9f\xe9\xf6m*.\xe4\xda;=TՍ\xb5_\xf2X\x8drN5\x9aI\x02\x80\xea\xdd\f\x9d\x89\x89\x04Ķy\x14\x9e\xa9\xceE\x9c\x148H\x19\x04Oݻ\xd6\x06=\xcc9\xfd\x92\x18\xad\xf4\x86*x\xdc\xcfsw\a\x1b\xda\x04\x11\xef)\xd9{zy\x92\xad\xdfl\x00`\x1aZHl\xf0~\xa0d\xe4i\x05\xc1\xb4w\xb0\xd6*\x13,\xf2\xd65,\xf7\x84\xfb\xd5Pxf\x12\xf3\xf3掮(\x8eԭ3\x1b\x00\xaa9/\x90\x8c\x98>S9\xaf-P-\x05\\A0q\xc9!\xeb6\xdd_\x94\xcf{\xee\x12O]C\xf1wn>\x19\x82R<\xee\x87a\xe7j\xf0ɺ\xb6\x8cq\x80\x01\xc8I\x0f\x1f3\x9d\x17 \xda\x01\xf5L\xd1\xd0t\x8a;cZ\xdd\x1f\x80\xe0\xa7\x7f\xab\x91\xf5\x0e\x10{\x97\xb1\a*=\x8c\xa33C\xddwߚǍ\xd4\xc0\xddS\xc7\xfd\xf3\x89e\xbe\xbb\xbf\xff\xf5\x89\x16\xde;Ԙ\x0e\x00r\"\x16\x19-\x9e;\x13AE_\xea\xb6\xf5\x9an\x02\x83JI\xb4>Z\xb2\x8a\xe7\x8fg\xf2t\xb9\x82\xf1\xb7Y\xfa9B\xbe\xc8\xeb{\xcb\xfd\x90\x84,:\xd1~aN@\x01\x80J\x01<X;\xeb\xdc[_\xec\xeb\x93\x1b\x00\x04~\xb4\x10\f+\x18X<B\x02\xa9$\x7f\xb1C\x8b1\xa7\x95\xfbsR\x93zy~?\x82Yj\xc9ZR\xc0P\x9d\x9d<\xf1\xdf\xee\xcb\xf2yO\xfcx\xa6\xbb\xd5\x7feX\x88\xda\xf1~\x04\x00X\xd7\xe6k\xbaQכ\x9fɪ\x02\x14\x9fpҩV\x8d\xb6\x00\xf3\xbb\xb7\xe7d1\vV\x86\x9b;T\xbb}x\xe79Q\xe1n\x9f\x7f~\x8ck<\xfb4\xe5\xad\x19\x00bQ\x06\xd3\x16@\xc8V\x0e\x04\xb0\a\xb4\x80@\x88\x18ʨ\x02p皼\x9cqo\xa4\x80h/\x14\xb2\xc4\xff\x92\xf44\xae\xf1\xa7\x96X\x92\xefl\xf7%\xd2\xdd?\x90\xac\xc5\xd86\x93\x04p\x0e\xae\xc3\x17 \x80*\x00\xc7y \x05\nU\xc6\x05\xb8\xe2\x9f\xf59$\x92Z\xbf?fy\x0e\xd3\xf8\xdb{\xb4\x89\xf7B;|]\x16\xee\x11\x00D\x1d\xc5\xe50\x80\x005oz\x81\xa0\xfb=y)p[v\xc6\x15-\xa2]\r\x00x\xe2\x7f\xa8\xe1\xb4\xea\xe7f\xc5A`,\x9f\xef\xedKע^\xcd(\x01\x9c\xaa\xe0q\xdey\x14<\xea\x05\x02\xe8[9\xb1{G\xee\xcd6-\x93+\x15\"ZĿZ\xe3/*/]\xc8\x02J\xe9\xbd\xfa\x83<ׯ\xde\x1f\xd5\xcf^%sD\x10\xec\xf0\x06\x04\x10\x93jH\xce\xe7Fp\x88SE\x94)\x97)\x143\x7fn2zAb<s\x92\x10\xa0Rk\xfc\xf1t\x7f\xd7e\x87\xcd,\xdc\xef5\x00D\x10\x94{\x05\x82\xa77\xa8\x8a\x16N\xa7U\xf9R`i\xe6\xc2k\x1eH\x01\xe6\xb9\x159Y6#\xb8\x7fסc\xdd2\xdc_\x17\x90\x00\xf0\x16\x04p\x91 \tB\x19\xae\x92\x16)\xb0,;#Y\xad\x88W:\x97\x93\x92\x14\xc9\xd6\xffm\x1es?\xb5\x8b\x86e,\x7f\xbfp\xbfn\x00P\v\x02^\xe6p:Z\xb8\xc1+)\x005\xc0\xf1\x06J9\xe2?\x8b\xe5\xfe\xc5EFt\xb3Jܑ\xa4R\x13\xf9\xe3q?}\xff\t\xceOv\xfas\xd1K\xa8\x9e\x17\x13AP\xcf;\xff\x1f\x9fՎ\xf0@\x10\xbb\"\x87$\xac_\xe6\x95\x14\xe0\xe8n+\xc7\x0e`\x02c\xf1\x82\x14fx\xf6\xb2J\xf1\xcf\xe2\xfeo\x9aO\x8f\x8e\x8cOX\xbd\x89W\x04\x04\x00\\\xf4*3\x9bumr2R\x0e\x04ɏݥh\x0f\xc8I\x81\x8c\xf9\xf3\xc28?+R\v\x80\xdbnJg\xea\xffA\x15\xe2\x9f\xc5\xfdȔ\xeeml\xe2-\xbfz\xdd\xdf\xeb\x1eu\a\x80\x18\xc8(U\x02\x01/y\xa4F\x15\xf0\xa4\x80L\x95r\x91Z\x03\x90\x95\xfa\xc5\xe4O\xaa\xa8\xf0eq\xffG\a\x1b\xba\xf1ά\x18\x97?u\xbf\x91\x12@\x15\b\x90<b\xd5\x11\xc0\x1eHzt\x9d\xa2\x14`E\a\xd3\x12\xe2U\x19{b\x84\xd0M$cU\xb3\xa7\xc1\x1f\xb8\xb3R\xee\x87\xdb'\xb3\n\xb8\xca\f\xedqB\x8d\xba\xb0\x12\b\x90<z\xfdӯ\x98!cD\xd0 N\xe5\x88\xe5\x92!*\x885\xf9*$\x00\x93\xfb\xf3\xd2Sc<\x15\xffɏ\xb9\x83\xf6\xc3\xfd\r\xbc\xa0\x0f2~~\xe7~C\x01\xe0\x02\x822\xc2\xc9\x1b\xc8e\x10\x95j\bP\x81˪\x1cJ\xb2\xc6\x0eq\f\xc1x%\xfd_\x98\x99\x9e\xe0\x89\xf8GDS\x1a\xf3\x87\xdb\xeb\x18\x1e\xe1\x05}ʉI(\xd4\xe8\x1b\x88FN)\x91I\x1e\xc1J\x96~\x8e\x01U\xaa!`q&\x1aS\xa8\xb0\x03\xdc\xea\xfe!9X\ue7d2\xf8G\xfc\"\x89\x1a\xafR\xc3oס\xc6\x103\xba}>\a\x80\b\x82F\xb9\x80̟\x8f\xfc\x18\x01}\xc92\xaa\xb0\xb2\x86G,o\xe0\xe6\x94\xf9\x99rv\x80\x18\xfeu\xa3̤y,\xc9!\x9b\x89t\xaa+i\xbe\xff\xd3#?\xf6^\xbd6\x19\xc11\xfcL\xd5\xf6&\xd4W7\x12A\xc0M\x1e\xbd\xfb\xf5\xfeQ\x96Qh\xfb\x15_\n ./U\x03\xa9|C\xb0H\x9c|\xa6\xf8\xcdOO\xb3i\r\xfe\x00\x9c\t\x92\x8c\x1f\xbc\x9b\x866\xbbM\xc6\xf0\xb3_\x97\x00\x10APM\xa6{\xe2\xb9\x118\x86z\x06\xbd\xee\xfa\xb5@\xd6 \x94\xaa\x01\x99F\x15\xa8\xf9\xab%\x9c\x85\x1f\xac\xf0\xafR\xe8\x17\xe0\x94r\xff\xc7\a\x0fs\r?\xe2\xe3j\x1f\xd3\x01@\x04\x01D\xe0N\xd69d\xcaX\xf6\x80\x9c-\xc0R\x03\xf3bcڵ<\x13O\xff\x0f\xca\xe8\x7fp\xbf\xb4\xd8\x13j\x8c\x1e\xdcl\x9f\x19\xbb\xa2\x86\xfa\xe9\xbe\xe5\x84S\\\xba\xb7\xb1iR\x1a$\x82\x7f͓\x02\xac\xf4l\xe6\xfcDM\xab\x9e\v2\x17\xb8e\x12\x95R\xbf,դ\x90\xed\xab&&$\xbf\x00\xc0\xc5=d\x05\x89\xa2XE\x13I\x8f\xf1\x83C\xd2\xd5D2]7\x98tW\xc1-n\\\x8b\x85\xabr\x96\x7f̊En\xdco\xc6l\x9fY%\x80\xd3(\xfc\x03O\x15 }:KLg&1U\x01&#B\xb2\xec\n\xe1ܹs挩y\x0e\xf4%b\x89\x7f\xdej^\x9e\xe5/\xc3\xfd;\xcd\xda\xf5\x144ן7G4Ll\xc4䖖E\xfa\xb4 c6#\xc3\xe2v\xd4O/\xc8@\xd2\bj!V\u0089\x02X\xc2,\xe4\x9f~\xb91\xbc\xf3\xf2l\x95{\xbe\xb7\xef\xf2\xe0\xf0\xe8@\xb8Œ2/6:\x1c@\xa1\x93\x1f\xa9\x89c\xe8\xc4K-\x7f\x05\xee\xaf &&\xbf\x02@\x8c\xc9g\xb1\xce!}\x8a\xac\xa1k\v\x15p]\xc6\v\x0f\x93\xd0\xe8\bf\xad\xbd\x14\x04\xd2\xc4\x0e\xfd?\"}\tj\x9e\r\xd1=\x84\x9b\xa5Q@H!\xe9\xbd\xc5*_\x96\x91\xb2\xc3ln\x9fiT\x80\x9a֫#\xe3\xee%\xff\x88\x10\xceQQ=\xe4-\xe1>\v\xff\xbeL\x00\x82\xb3Z\t\x96\x7f\"\xc3\xef7S\x95o@H\x00\x97\x8e\xdb\xdc\xc9GG\x10ʱ\x89\xfe\x1c\x1c\xc1\xfb@\x7f\x1f2\x1d\x11dU\xfa|\x7f\xfa\xac\x83\xf3\x1e\xa6\xe7~\x7f\xaa\x00\xb8D̐-ʺ6\xdf^\x84\x9e\x81\x89f\x1a(\xd6䃎\x9e=?\xca\x01@5\t\x00\x9a\xeb\a\xeeG\f`3o\xf2\xf5j\xc0\xe8\v\x82\xf8\x1f\x18\x19e\x19\x7f\xc7\xccl\xf9\xfb\xcd\x06\x10E\x7fU0L\xbe\x00\x00~\x83\xa7\x9a@y\a_\x1b\x81\x95<\xbd\xff\x9b\xbbW\a\xd4\xe4+P\xdd\r\x00\xb8s?\xdc=f\xf7M\xac\xf3׳\xe7\xb0\t\xa8\xf4\x06\x00T\xbaD\xe8\xfa\x85\xb6\xe9\x818ˑa\xbc\"dvl\xe3\xba\x05\x80\xa8\xfb\x99\xb1\xff\r˖\x86\x05*\x9b˨\xac\xa2\x1b\x00\x98M\xe5\x84Ӂ\v-\xe2\x03Y\xd6sRυz\xb6\xaf\r\x16\x00\xb8\x91\\\a\xae@!\x99\xd4s\xe9\r\x00\x90\x99\x90\xaf[\xb2\aE\x18\x81\xce\xfd \x99\xd4s\xd9\r\x00\xc8\f\x04\xab\b#\x10\t\x8d$8\x8bR7\xfbjߟ\x80\x04\x80LW\x8f\x80#^?!b\xa2\xfa\x7f\xbf\x00\x80\xb7\x04\x1b\xe2?\x88\x82>\xdc~B\xc4\xe4\xb5\x00\xbe\x90\x00\xa5\xc1,\xfeU\xa8\x81L\xa3\xb6\xb5\t\x14\x000\xc5?o\tv \x93L\xbf\xc2\xca\x1b\x12\xc0\x85\xc0)A\x16\xf6\x9d~Q~\xbf\xc2L\xdej\xa4\xa0\x06\x80\xd6\x0e\\\x81N\n\xfd\n+\xaeG\t\xa0\xa9\x03W0\x10\xab\xbc\xdc\xc5%̺\x01\x00\xc2\xef\xc0\x15\f\x84\xf2\xf2ܴ\xe4N-\xe3\x11\x94\x00\x10ſ[\xc9\x17b\xff\x9el2\x1dP.ᢛ\xd2\x02)&`\x94\x04`\xbe\xec\xca\xdc\xec9$\xc8\t.a\xb8e.\xab)\x94)\x13Ds\r\xe0~\x84?\x99+pY\x1d8䨳\xb3\x93\xecڵK\xf8wZZ\x1aY\xb7n\x1d\x89\x8d\x8d5t@jkkɩS\xa7\xa6\xed\x95\x15+\x84C+\xe5-L\ri<\xd7\xc1S\x03UA\r\x00\x9e\xaeC\x03&*\xfe\xd3\xd5^\x04\x13\xff\xe2\x8b/\xce\xfa,55\x95TUU\x91\xdc\xdc\\\xdd\x1fzpp\x90TTT\x90Çg\xf7\x1ez\xf0\xc1\a\xc9\xf6\xed\xdb5]\xab8'\x8bp\x00Pj6\x00\x18\xa1\x02\x98>oI\xfebՓ\xdf\xd0\xd0\xe06\xf9\xa0\xae\xae.\xf2\xc2\v/\x182\x10\xaf\xbc\xf2\x8a\xdb\xe4\x83v\xef\xdeM\xde|\xf3MM\xd7B\x9ccNh\xa8걹.\x00\xc0k\xbf\u03a27\xdex\x83{\xae\xb5\xb5uF-\xe8EP5\x98h\x1e\xbd\xff\xfe\xfb\x9a\xaf\x99\x1c\xcflm\x83fU\xb7\x06;\x00ܬ\xff\xf9q\xb1\x17\xb4\\\x80ŉ\xaet\xe8\xd0!]\x1f\x18\x12G\x8e\x86\x86\x86\x14\xbf#\xa5\xd4xn\xb2뀙\xf2\x03\xba\x02\x80\x17\xf2\\\x94\x9atUo\x8e5\xf3\xf5\xa6%^*\xef\x14\xaa\xa0\x84m\xef\xcd\x10\"\xf6IIXJ\x825I\xcf\xeb\xe9\xed\t\x18\xe1Y\U000366d3H\xcaZ\n\x82\x1a\x7f\x16\x8e\xe8\r\x00\xa6\x9f;/6ZS\xf4o\xf1\xe2Ų\xe7\xef\xba\xeb.]\x1fZ\x8d\xab\xa7\xd5\xf3А\xf0\xc229\xbfI\x03\x9f\x00@+=\xfd\xf4\xd3\xdcs111B<@O\xc2\xe4._\xce\xdf\xe8\xfa\x91G\x1e1:\xfe`\x15\xa5Ay\xa0\x03@\x17\xc2\x04\xc3\xfffM\xfe;\xef\xbcc\xc8d \xbe\xc0\x92<\xf8l۶m\xbezu\xd8\x06վ\x1ck\xbd\x03A\xba\xb5AC\xf0e\xf3\xe6\xcdd\xe7Ν\x82\x91V\\\\l('\xe2\xba\x00\x17\\L\xa7\x97\x01U\xb3i\xd3&}EdҼɱ\x89\xab\xa1\xbc=\x13(m\xa5 h|y\xcbC>\t\x18\x85LMM\xe9\xed\x05\xd4J?G#\xa6`(\x01\xd7BX:\xfeJ\xcd\x1e\xb7\xcf\xef\xbe5\x8f\xdcC\x8f\xda\x13-c\x9f76˵:y\xdc\x17\xad\xe5|\xa2\x02\xec=\xbd}\xe4:#\x99\xa5\xe3\xd3j\xae\xe0\x96\xf0m\xf7\x97L\xc8x\vU*w>5\x0f\x00xM\x11ںz|҈\x02\xe2\x1b\xa1b\x9e_\x8f`Ϋ\xaf\xbe*\xc4\xfd\x8d&\x9e\x88Os\xa9\x86Μ?\xcf\xf2\xb7\xf7\x95\\\xe3\x80\x00_\fH\t\xe0\xb6A\x04\xbah\xf0\xb6\x88ѓ\x10\xcfGHw\xe3ƍ\xb3\x80\x80\f\xdf\x13O<A\x9e|\xf2I\xf2\xc1\a\x1f\xe8\x1eJf\x11\xab\xfb9(!f\xf6j\xb8\x94\xf8\xb89\x00\x01\xe72H!W\x06\x1a\x00\x98R\xe0\xeb\x13-\x86\xd7\x02\xba\x1a\x88N \x14\x15\x15\x91g\x9f}v&\xbcl\x84\x1b)%t=o\xee\xe8r\x0f\x0eY,\xcc\x15\xc5\x00\xc1\xc3k\x8ay\x8d-+\x8c\xac#0\x02\x00L\xb1u\xf4\xec\xf9(\xa3\xa5\x00\\9L\xb0R\x8c\x01\xb5\x05Fҷ-\xec.\xe3r\t\xb1\xa2\xac\xf4\xf0\xfc\x8c\xb41\x8e*0L\n\xe8\xea\x05\xb8x\x03h\x03\xeb\xb6\"(;y~\xefo\xef\xbd\xd3ТP\x88}d\x13\xa5\xd9=\x00\x03\xae\xa5/\xb8\xff_\xfeg\x0f\x19\x9dp\xdf\xfb\xe0ђ\x95\xb2 \xc0o\x84ߎ3\xf7\x98|\x80\x1eN\x0e\xb2\xebՂ\xce(/\xa0\x8a\xa3\x17mr\xfb\b\xebA\xe0nL\xf4\xdbo\xbf=\xeb\xf3-[\xb6\x18>\xf9\x82\xeai8Μ\xfc\xf8\xe8(Ŕ8T\xc4\xfdE\xf9\xbc\xf1\xf9Tt\xb1q\x9c\xa3L\xd6/\xe6\x11JM\a\x00\xd1\x7fe\xee\x16\xb6\xf3\x87\xc6)\x99 \x88jB\xd9֚5k\x04\xe3\x0eF\x1e\xeb\xbc+\xb5\xb4\xb4\xb8Y\xff\x90\x16\xa8\x02\x82\x9d\xa0\xb5\xe8\x83E\x87ϴ\x93#g\xd9[\x15\xac\xc9S\xb7K\xfa\xca\xc5ّ*\vg\xa1\x1a\x84M0(\b\xea<\xb5\x13\xe6TV\x1a\xa3^\xbe:~\xb2\x850\x8aC\xa9ʱ4\x9ci\x1f\xc9[\x98j\x89\x8d\x8c\xf0\xf8\xfa{\xf7\xee%uuuB\x95\x10\xfe\r\x030<<\x9c\xd8l6\xc1\x03x\xf7\xddwg\xc7\"\xecv\xb2\x7f\xff~\xe1;\x00\x02@\xf3\xfc\xf3\xcf\v\x05&N\x15\xb1~\xfdz\xaf&\xff\xe3\x83\xec:\x06p\xff_\xdfy\xbb\xeakER\xb7\x90eD\xca\x10&\xffq:\xe6{\xee\xb95\xef\xa2\xdfm\x00\x17[\x00\xaa\x80\xd9\x19\f˨~\xbd\xa6x\xaa c\x81Gu\xe2\xe0^X\xf9z\x903\xc7\xe0i\xad\xe1~j\xf4\xfd/\x15\xfd<\xa2v\x8f\x96젒- G\x10\xad\xa5b+~\xbf\xda\x00NUPAdv\x0f\xfd`\xdf\xf7Q{\x8e\x9e\xf0H\x1f@\xd7#7\xe0\xcf\xc9\xc7\x04}t\xe0\xb0\xec䯾%\x87h]\v\t[ ?\xdd#OEs\xf0\xc8\x17\xa1\xe0R\x1e\b@\xf5M\xad\xd6\x7f\xfe\xe4\xb3^O\xec\x82\xe7\x9e{N0\xf6x\xf5\x03\x00\xc87\xdf|C^{\xed5f\xba\x17\x19\xc7\x0f?\xfcУ\xc9\xc7\xf3\xbe\xf5\xc5>\xae\xce\aݔl#\xbfX\xe1Y\t\xe0\x92\x85l\x00P0\xfd\xcc\xdb\xe2\xd6%xTn\n\x15\xe0\xa2\n\xe2\xc5\x00Q\xa1\xdc\xf7nY\x98ڷiEa\xa2'\xab\x87`\xf49\x8d<\xd8\x03F\x94\x8e;\xb9\xfe\xcb\xe3'\x05\xb1/G\b\xf8<u\xefZ5\x95A\\\xfaÇ\xbb\xdd\xd4\xc0\xb2\xecL\xf2Ww,\x17\x92M\xd8\x7f\x19\xbb\xaf2~\x8a^\xc5E\xa6\x01\x80\x16\x10\x80\x16\xa7%\x93\xf5\xb7\x15\x103u\x11\xc1D \xc0\xb3\xffd\x1b\xd3͓N҃\x94\xf3\xbd\x99|\xd0{\xf5\xdfQc\xb0\xd3͠|\xfe/\xa7\x8dU\x80\xe0\x8f\xbb>\x1f\xc6>K\f\xf5\x1bb\x16\x15\xe0| \xe7f\xd2;\x94\xbe\xdb\xda\xd9M\xfe\xfdӯ\xc8˟\xfc\x99|\xd7z\xd6\x13cH7\xc2 \x83\xe3a\x94Q+[q\xf2\x91\xee\x05\x87z;\xf9\x82D\\\x90\xe2\xf6\x19\xe5\xf8\x99\xf1\x80\xa4\xccIM\xfa\x99\xc3p\xaa\xe2\x03>m\x17/\x82\xa0\x1c~\xab\x18,\x92e\xf1\x81\xe1Q\xc4\r\x84\x83\xea\xd3)j\x18\x85\xc0\xa02Z2`\x80\x9b(\xe75\xffԩ\xda\x1d\x83ᆉ_\x92\xae_\x989\x85\xbd\xb6\x80`/$\xa7a\x89\x1dOO]\xb8\xe8\xf1=\xfc\xb2a\x04\x02E\x88b\x89\x16\xebf5\xbf9\xd7\xdd\x1bB\x8f\x99\xc1\xceN\xb1\t\xdb\xc4fSC+!:\x9ax\xb3\xea\x18\\\xdeE\a\xb5\x93\x1av\x10\xb9Z\rR\x18{\x8f\x95\xac҅\xeb])\xdd\xc6\xde3\xe3,\x1d\a\xbd\xba\xac\xf8m\xd3(\xe7ށ\xa2\xa8B4\xaaD\x8b\x9f\f\xce\xc4\xf1\x95\xc4\xf0\xc2$ \x90\"\xb3\x87\xb08\x88\x97\x9c\xc0\xf2\xf8\x1d\xa0\x8f\xa1\xeb\xc1\xf5\x8e\xe1\x91\xc9O\x0f\xffx\xb5\xe1\x8c]\xe8}\x8cb\x0f\xe4\xfb\xbd\x1d'\xbc\x93\x14\x90\xaeI5*\xa9\xf0\x02\xe9\x01\a\x00\x17 @\x1d\x94z\x02\x04\x96k\xe6$\x8d\x914\xcd~\xfa\xea\xbc\x1c\xb2\x86\xfa\xf8\x00\\\xed\x89Sß76A\x04\t\x93\x8fϨ\xf8\xd6elY\x1dɝ\xd5F\x00B[W\xcf<\x99q5?\x00\x18@Ȣ\x7f\xb12\xf4qb2\x02\xc7#\xa6\xbf\x9cZ\xf9Nq\xff\xa7\x83G\xc6)\xd7\xcf\xd2?k\x97,\x1e\x0e\xb7Xt鄑\xbb ٭\xb8\x046\n\x0e\xb8\x81\xd4\x03`鉝\x01#\x01\x18@\xb0\xd3?\xbf\xa1@0\r\x00\xe0\xd6姧\xba\x19x\xd4C\x19\x96N~Zb\xfcЪ\xdc\xec\x18\xbd\xee=q\xf5\x1aSҽR\xb3\xc712>\xc1\xdbX\xab&`\x01\xe0\x1a̐\xc6\f\xa8\xe13\x1e\x1167\xac\xb3\xcf!\xb8CFr:\x8c,֤\xbb\xda!_46\x0f\xd1\x7f:\x010B\xa5B\xe4\xa3%+c\xa0\"\x8c&l\xac\xc9\x1b7-\xd5\xc4f\x06\x00k\x8dAأ\xd4\xdav\x8aA\xb8C\xe0\x06\xe8B\xfc\x1b\x9fi\xb1\xe0\x85\x12\xadD\xeb\x8cш\x82M\xfcU\xe3Q \xfb7<>\xee\xba\xe6q\n\xe5\xde\x00\x8f\x1f\t/_\x1ePF\xa0\f\xd9\xe5\fB\xe7ְr\xee\x10\xaf0S\x0f\x17\n\x00p\x1dx\xfa<\xd6\xe57g\xfa{\xcc*\xb4d\x02\x03\x01\x00L\xebW-\x19Ց\x14\xe2_\"iF齬\xbe\x10\xfd\x1c\x02\x1a˴N\xbe\xd9\x01\xc0\f\xd8h%d먾\xa4\xe2=^\b\xd8\xe8A\xc8\aP\x82\xff\xed\xbc`r\x9aA\xd1\xc9Ny\x95\x86\x93\x88\xa8V\x89q\x15\x12\xd4\x00\xd0Jo\x7f\xf9\xed\xe0\x99\x8b=3\xb5\xe2\x10ѿZ\xb5\xdc\xe3\xeb\x8dMLL\x1dl=;\xf8\xe5\xf1\x93\x16\x97\xc97T\xda B\xc91\x90+\xf4؝\xd4\xcc\x00\xc0˽\xe4\xcd\x05\xa6\xa6\xa60\xf9g\xe8q\xb3SoÇ\xd7*\t\x10\xe5\xfb\xe2XshSGg\b54\xa5\x01\xfa^)\x18t\xb3\xe8\xe8}\xa9\xd4c%\xec\xaa\xf5ښ\xd6\xcc\x00Ыk\x06&\x1f\x95\xb6\x1e-N\x85\n\xf9\xe8\xc0a^\xd6\x14\x85\x19\xe9\b\xd7\u009bЛN\xfe\xd45\xc6y\xee:\xbd\xeeaf\x00x\xbd0rd|\xa6\x85?\x06\xb1\x1b\xbaڃ\xc9gN\xb8X\xb2\x95\x0eo\x04\xaaEo\x03\x10\xeaf_s+k\xf2\x1d\x9e\x18{\x81\b\x80,\xe9\aZ\xd3\xc0\x12K=Y\xeb\x03\xec\x96\xd4\xfa\xd1\xfb\xa7?V\xba\xca'\xbe\xfe\x97\xc7[\xaeQ\xf1Ϛ\x9fj=\xef\x13\x1aH\x00\x90.\xac\xf4D(\xa8\xfd\"\xb2\x84.\x85(\xe8rJ\x9e\xbao\xadO&\xff\xbbֳ#ߞ<\xcdcN]\x1bG\x98Y\x02\xb8\x05\x812l\x89`io\x94m\x0f\xd5՞Dk&Q\xddk\xb4\x9f\x8f\xf8\xc2\xc7\a\x0f\x8f5\x9d\xef\xe4\xd9+\xaf\xeb\xb5$\xec\xbap\x03\x19\x14\xe7\xe1$F\xa4%\x18\xd7\xc9\r\xd6\xfe\x81\x963\xa3?\xb4\x9d\x8b\xa2R'\\&\xd8S\xa9\xf7\xbd\x03\n\x00)\xf1q\xaa\x97\x12\xd9{z'\xa5*\x8e\xfa\xea\t\x1e\xde:\x16Qțt\xf2\xf6`\xe0\xd9/\xf5\x8d\x9e\xbdxi\xeatWw\x14\xb5U\xf0\x9cQ\n\x01\x9f2O\x83=A\x03\x00:PWs\x17\xa4\x84\xabr\xa1.\\D\xdc8v\xb6\r\xa1^\x7f\xb7L\xff\xdeitD5\xff\xd45\xbe,;S\xf3N\xe7\xdd\xfd\x03W\xfb\x87G&.^v\x8c\x9f\xef\xed\xb3\"\xb0C\x8d\xbb\x10\rn\xa9\xe6\xd5>\xc1\x02\x00\x88\xbcY\xfa\x9a\x8a\xc8\xe8U\xb9\xd9S\xe1\x16\x8bl\xc9\xf3\xc5\xfe\x81k\xfb\x9aZ\xddډ\xa9\r%\xe3\xf7\xb8\x97\xebgT/\x87a\xedߊ\x9b\xb3\xae\x86\x86\x84̴\xbe\xed\x1f\x1e\xbeJ\xaf+$\xed{\a\x86\"\xfb\x86\xae\b qf'\xc51\x9e\xeba\x1c\x02\x11\xbfr\xa3&\x1f\xe4\xb3u\x01ZI엷\x95\xe5\nn]wǤ5*\x92\xe9\xc1\xb4_\xfay\xbc\xba\xf6@\x18\xa7\x94\xbc\xbb8'+f\xe3\xf2\xa5\xd1,[\x005\x06\x8d\xe7:\xae\xd47\xb7F\xfb\xb3\x14]\xe4z\xc4\xf7+\x8d\xbe\x91\x99\x01PJ\x18-眄B\r\xd1+\x10hht,\xaa\xa9\xe3\x82E-\x97\xa7%ƏE\x87\x87\x8b:u*\xacwp(\xc1\x17}\x8cTH=\xb8y\xd5F\xe8\xfb\x80\x02\x80\x9c\x14\b2\xaa'ӡ\xdd\x1a#E}\xa0\x1a\x81X]\x8c\x90pa\x90L6tz\xa3\xf3\xd0+\xa1\x13\xb4\x12@\x94\x02p\xc0k\x88\x17\xe5\xe2^\xea\xe2J\xf1\xfee\xe2\x01@Z9\x9c<ㅊG\xbf8\xd9\xfd\xfe\xe0\xee\xa0\x00\x80\v\x10*\xc4\xc9P\x1b\t\xdc)~\xbfT\xe3\uf7045\x8c\x95zG\xden\x00\xc0{ 891K\"\x15\xdaEns\xeaS;\xe3w\xa5\xe4\xff\xb3\x8c\xae\x9c|̅[\x1b\xc5\xdf\xf7\x93\xeb\x80\xfeO\x80\x01\x00\x908\xba\x06Ⱦ\xbc\xbf\x00\x00\x00\x00IEND\xaeB`\x82",
+	"root.html":         "<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n  <meta http-equiv=\"Content-type\" content=\"text/html; charset=UTF-8\">\n  <meta name=\"viewport\" content=\"width=device-width, initial-scale=1, maximum-scale=1, user-scalable=yes\" />\n  <meta http-equiv=\"X-UA-Compatible\" content=\"IE=edge\">\n  <meta name=\"apple-mobile-web-app-capable\" content=\"yes\">\n  <meta name=\"mobile-web-app-capable\" content=\"yes\">\n\n  <title>dlibox - {{.Host}}</title>\n\n  <link rel=\"stylesheet\" href=\"https://maxcdn.bootstrapcdn.com/bootstrap/3.3.6/css/bootstrap.min.css\" integrity=\"sha384-1q8mTJOASx8j1Au+a5WDVnPi2lkFfwwEAa8hDDdjZlpLegxhjVME1fgjWPGmkzs7\" crossorigin=\"anonymous\">\n  <link rel=\"stylesheet\" href=\"https://maxcdn.bootstrapcdn.com/bootstrap/3.3.6/css/bootstrap-theme.min.css\" integrity=\"sha384-fLW2N01lMqjakBkx3l/M9EahuwpSfeNvV63J5ezn3uZzapT0u7EYsXMjQV+0En5r\" crossorigin=\"anonymous\">\n  <script src=\"https://ajax.googleapis.com/ajax/libs/jquery/2.2.1/jquery.min.js\"></script>\n  <script src=\"https://maxcdn.bootstrapcdn.com/bootstrap/3.3.6/js/bootstrap.min.js\" integrity=\"sha384-0mSbJDEHialfmuBBQP6A4Qrprq5OVfW37PRR3j5ELqxss1yVqOtnepnHVP9aJ7xS\" crossorigin=\"anonymous\"></script>\n\n  <!--\n  https://github.com/DavidDurman/FlexiColorPicker\n  -->\n  <script type=\"text/javascript\" src=\"/static/colorpicker.js\"></script>\n  <!--\n  <link rel=\"stylesheet\" href=\"/src/themes.css\">\n  -->\n\n  <style>\n    #background {\n      position: absolute;\n      top: 0;\n      left: 0;\n      bottom: 0;\n      right: 0;\n      z-index: -1;\n      overflow: hidden;\n      color: #dddddd;\n      font-size: 128px;\n    }\n\n    #picker-wrapper {\n        width: 200px;\n        height: 200px;\n        position: relative;\n    }\n    #slider-wrapper {\n        width: 30px;\n        height: 200px;\n        position: relative;\n    }\n    #picker-indicator {\n        width: 3px;\n        height: 3px;\n        position: absolute;\n        border: 1px solid white;\n    }\n    #slider-indicator {\n        width: 100%;\n        height: 10px;\n        position: absolute;\n        border: 1px solid black;\n    }\n/*\n    #picker {\n      height: 200px;\n      width: 200px;\n    }\n    #slider {\n      height: 200px;\n      width: 30px;\n    }\n*/\n  </style>\n  <script>\n    \"use strict\";\n\n    var patterns = {\n      \"Aurores\": \"{\\\"_type\\\":\\\"Aurore\\\"}\",\n      \"Canne de Noël\": \"{\\\"MovesPerSec\\\":6,\\\"Child\\\":{\\\"Frame\\\":\\\"Lff0000ff0000ff0000ff0000ff0000ffffffffffffffffffffffffffffff\\\",\\\"_type\\\":\\\"Repeated\\\"},\\\"_type\\\":\\\"Rotate\\\"}\",\n      \"Ciel étoilé\": \"{\\\"Aurore\\\":{},\\\"AuroreIntensity\\\":1,\\\"Stars\\\":{\\\"Density\\\":0.3,\\\"Intensity\\\":255,\\\"Seed\\\":0},\\\"Supernova\\\":{\\\"AverageDelayMS\\\":60000,\\\"Color\\\":\\\"#ffffff\\\",\\\"DurationMS\\\":5000,\\\"Seed\\\":0,\\\"Size\\\":5},\\\"WishingStar\\\":{\\\"AverageDelayMS\\\":10000,\\\"Color\\\":\\\"#ffffff\\\",\\\"DurationMS\\\":1000,\\\"Length\\\":10,\\\"MovesPerSec\\\":60,\\\"Seed\\\":0},\\\"_type\\\":\\\"NightSky\\\"}\",\n      \"Cycle RGB\": \"{\\\"DurationShowMS\\\":1000000,\\\"DurationTransitionMS\\\":1000000,\\\"Patterns\\\":[\\\"#ff0000\\\",\\\"#00ff00\\\",\\\"#0000ff\\\"],\\\"Transition\\\":\\\"ease-in-out\\\",\\\"_type\\\":\\\"Loop\\\"}\",\n      \"Dégradé bleu\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#0000ff\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"Dégradé rouge\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#ff0000\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"Dégradé vert\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#00ff00\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"Dégradé\": \"{\\\"Left\\\":\\\"#000000\\\",\\\"Right\\\":\\\"#ffffff\\\",\\\"Transition\\\":\\\"linear\\\",\\\"_type\\\":\\\"Gradient\\\"}\",\n      \"K2000\": \"{\\\"Child\\\":\\\"Lff0000ff0000ee0000dd0000cc0000bb0000aa0000990000880000770000660000550000440000330000220000110000\\\",\\\"MovesPerSec\\\":30,\\\"_type\\\":\\\"PingPong\\\"}\",\n      \"Morning alarm\": \"{\\\"Cues\\\":[{\\\"DurationMS\\\":600000,\\\"Pattern\\\":\\\"#ff7f00\\\",\\\"StartMS\\\":0,\\\"Transition\\\":\\\"linear\\\"},{\\\"DurationMS\\\":600000,\\\"Pattern\\\":\\\"#ffffff\\\",\\\"StartMS\\\":600000,\\\"Transition\\\":\\\"linear\\\"},{\\\"DurationMS\\\":600000,\\\"Pattern\\\":\\\"#000000\\\",\\\"StartMS\\\":1800000,\\\"Transition\\\":\\\"linear\\\"}],\\\"_type\\\":\\\"Timeline\\\"}\",\n      \"Noir\": \"\\\"#000000\\\"\",\n      \"Ping pong\": \"{\\\"Child\\\":\\\"Lffffff\\\",\\\"MovesPerSec\\\":30,\\\"_type\\\":\\\"PingPong\\\"}\",\n      \"Rainbow cycle\": \"{\\\"DurationShowMS\\\":1000000,\\\"DurationTransitionMS\\\":10000000,\\\"Patterns\\\":[\\\"#ff0000\\\",\\\"#ff7f00\\\",\\\"#ffff00\\\",\\\"#00ff00\\\",\\\"#0000ff\\\",\\\"#4b0082\\\",\\\"#8b00ff\\\"],\\\"Transition\\\":\\\"ease-in-out\\\",\\\"_type\\\":\\\"Loop\\\"}\",\n      \"Rainbow static\": \"\\\"Rainbow\\\"\",\n      \"Étoiles cintillantes\": \"{\\\"Density\\\":0.3,\\\"Intensity\\\":255,\\\"Seed\\\":0,\\\"_type\\\":\\\"NightStars\\\"}\",\n    };\n\n\n    function onload() {\n      // Set background.\n      var text = '';\n      for (var i=0; i<50; i++) {\n        text += '🐉🐢🐇🌴';\n      }\n      document.getElementById('background').innerText = text;\n      loadButtons();\n      document.getElementById('style').value = \"{{.Style}}\" || \"fade\";\n\n      // http://www.daviddurman.com/flexi-color-picker/\n      ColorPicker.fixIndicators(\n          document.getElementById('slider-indicator'),\n          document.getElementById('picker-indicator'));\n      ColorPicker(\n          document.getElementById('slider'),\n          document.getElementById('picker'),\n          //document.getElementById('fancy'),\n          function(hex, hsv, rgb, pickerCoordinate, sliderCoordinate) {\n            ColorPicker.positionIndicators(\n                document.getElementById('slider-indicator'),\n                document.getElementById('picker-indicator'),\n                sliderCoordinate, pickerCoordinate);\n            document.body.style.backgroundColor = hex;\n            document.getElementById('rgb_r').value = rgb.r;\n            document.getElementById('rgb_g').value = rgb.g;\n            document.getElementById('rgb_b').value = rgb.b;\n            updatePattern('\"' + hex + '\"');\n          });\n    }\n\n    function loadButtons() {\n      // Create buttons.\n      var styles = [\n        \"btn-primary\", \"btn-info\", \"btn-warning\", \"btn-danger\", \"btn-success\",\n        \"btn-default\",\n      ];\n      var j = 0;\n      var dst = document.getElementById('boutons');\n      for (var k in patterns) {\n        var node = document.createElement('button');\n        node.type = 'button';\n        var v = patterns[k];\n        node.id = 'button-' + k;\n        node.className = 'btn btn-lg ' + styles[j];\n        node.attributes['data-mode'] = v;\n        node.innerHTML = '<img height=5px src=\"/thumbnail/' + encodeURI(btoa(v)) + '\" /> ' + k;\n        node.addEventListener('click', function (event) {\n          updatePattern(this.attributes['data-mode']);\n        });\n        dst.appendChild(node);\n        dst.appendChild(document.createElement('br'));\n        j = (j + 1) % styles.length;\n      }\n    }\n\n    function updatePattern(data) {\n      document.getElementById(\"pattern\").value = data;\n      setPattern();\n    }\n\n    function setPattern() {\n      document.getElementById(\"pattern\").value = JSON.stringify(\n          JSON.parse(document.getElementById(\"pattern\").value), null, 2);\n      var oReq = new XMLHttpRequest();\n      oReq.open('post', '/switch', true);\n      oReq.setRequestHeader('Content-type', 'application/x-www-form-urlencoded');\n      oReq.send(\"pattern=\" + btoa(JSON.stringify(JSON.parse(document.getElementById(\"pattern\").value))));\n      return false;\n    }\n\n    function setStyle() {\n      var oReq = new XMLHttpRequest();\n      oReq.open('post', '/style', true);\n      oReq.setRequestHeader('Content-type', 'application/x-www-form-urlencoded');\n      oReq.send(\"style=\" + encodeURIComponent(document.getElementById(\"style\").value));\n      return false;\n    }\n\n    function patternKeyDown() {\n      if (event.keyCode == 13) {\n        setPattern();\n      }\n      return false;\n    }\n  </script>\n</head>\n<body role=\"document\" onload=\"onload()\">\n  <div id=\"background\"></div>\n\n  <h1>dlibox - {{.Host}}</h1>\n  <!--\n    - Button with thumbnail in it. Use nearestneiborhood resize?\n    - Checkbox to add it to the button based loop. Changing takes effect\n      immediately.\n    - Settings:\n      - Nombre de LEDs.\n      - Upload\n      - Draw\n      - Delete\n      - Alarm: HH:MM / Jours (DLMMJVS)\n      - Wake up: 20 / 40 min\n      - Bonne nuit: 20 min.\n  -->\n  <div class=\"page-header\"><h2>Choix d'animations</h2></div>\n  <div id=\"boutons\"></div>\n\n\n  <div class=\"page-header\"><h2>Custom</h2></div>\n  <textarea id=\"pattern\" name=\"pattern\" cols=80 rows=10>\"#000000\"</textarea>\n  <input type=button value=\"Set\" onclick=\"setPattern()\" />\n\n  <div class=\"page-header\"><h2>Transition</h2></div>\n  <select id=\"style\" onchange=\"setStyle()\">\n    <option value=\"fade\">Fondu</option>\n    <option value=\"wipeleft\">Balayage vers la gauche</option>\n    <option value=\"wiperight\">Balayage vers la droite</option>\n    <option value=\"wipecenter\">Balayage depuis le centre</option>\n    <option value=\"slideleft\">Glissement vers la gauche</option>\n    <option value=\"slideright\">Glissement vers la droite</option>\n    <option value=\"dissolve\">Dissolution</option>\n  </select>\n\n  <div class=\"page-header\"><h2>Couleur manuelle</h2></div>\n\n  <!--\n  <div id=\"picker\"></div> <div id=\"slider\"></div>\n  -->\n  <div style=\"display:inline\">\n    <div id=\"picker-wrapper\">\n      <div id=\"picker\"></div>\n      <div id=\"picker-indicator\"></div>\n    </div>\n    <div id=\"slider-wrapper\">\n      <div id=\"slider\"></div>\n      <div id=\"slider-indicator\"></div>\n    </div>\n    <!--\n    <div id=\"fancy\" class=\"cp cp-fancy\"></div>\n    -->\n\n    <span id=\"rgb_css\"></span>\n    <label>R:</label>\n    <input id=\"rgb_r\" type=\"number\" value=\"\" />\n    <label>G:</label>\n    <input id=\"rgb_g\" type=\"number\" value=\"\" />\n    <label>B:</label>\n    <input id=\"rgb_b\" type=\"number\" value=\"\" />\n  </div>\n  <br>\n  <h2>Configuration</h2>\n  TODO(maruel): Add alarms here.\n  <p>\n\n</body>\n</html>\n",
 	"themes.css":        "/* Common stuff */\n.picker-wrapper, \n.slide-wrapper {\n    position: relative;\n    float: left;\n}\n.picker-indicator,\n.slide-indicator {\n    position: absolute;\n    left: 0;\n    top: 0;\n    pointer-events: none;\n}\n.picker,\n.slide {\n    cursor: crosshair;\n    float: left;\n}\n\n/* Default skin */\n\n.cp-default {\n    background-color: gray;\n    padding: 12px;\n    box-shadow: 0 0 40px #000;\n    border-radius: 15px;\n    float: left;\n}\n.cp-default .picker {\n    width: 200px;\n    height: 200px;\n}\n.cp-default .slide {\n    width: 30px;\n    height: 200px;\n}\n.cp-default .slide-wrapper {\n    margin-left: 10px;\n}\n.cp-default .picker-indicator {\n    width: 5px;\n    height: 5px;\n    border: 2px solid darkblue;\n    -moz-border-radius: 4px;\n    -o-border-radius: 4px;\n    -webkit-border-radius: 4px;\n    border-radius: 4px;\n    opacity: .5;\n    -ms-filter: \"progid:DXImageTransform.Microsoft.Alpha(Opacity=50)\";\n    filter: progid:DXImageTransform.Microsoft.Alpha(Opacity=50);\n    filter: alpha(opacity=50);\n    background-color: white;\n}\n.cp-default .slide-indicator {\n    width: 100%;\n    height: 10px;\n    left: -4px;\n    opacity: .6;\n    -ms-filter: \"progid:DXImageTransform.Microsoft.Alpha(Opacity=60)\";\n    filter: progid:DXImageTransform.Microsoft.Alpha(Opacity=60);\n    filter: alpha(opacity=60);\n    border: 4px solid lightblue;\n    -moz-border-radius: 4px;\n    -o-border-radius: 4px;\n    -webkit-border-radius: 4px;\n    border-radius: 4px;\n    background-color: white;\n}\n\n/* Small skin */\n\n.cp-small {\n    padding: 5px;\n    background-color: white;\n    float: left;\n    border-radius: 5px;\n}\n.cp-small .picker {\n    width: 100px;\n    height: 100px;\n}\n.cp-small .slide {\n    width: 15px;\n    height: 100px;\n}\n.cp-small .slide-wrapper {\n    margin-left: 5px;\n}\n.cp-small .picker-indicator {\n    width: 1px;\n    height: 1px;\n    border: 1px solid black;\n    background-color: white;\n}\n.cp-small .slide-indicator {\n    width: 100%;\n    height: 2px;\n    left: 0px;\n    background-color: black;\n}\n\n/* Fancy skin */\n\n.cp-fancy {\n    padding: 10px;\n/*    background-color: #C5F7EA; */\n    background: -webkit-linear-gradient(top, #aaa 0%, #222 100%);   \n    float: left;\n    border: 1px solid #999;\n    box-shadow: inset 0 0 10px white;\n}\n.cp-fancy .picker {\n    width: 200px;\n    height: 200px;\n}\n.cp-fancy .slide {\n    width: 30px;\n    height: 200px;\n}\n.cp-fancy .slide-wrapper {\n    margin-left: 10px;\n}\n.cp-fancy .picker-indicator {\n    width: 24px;\n    height: 24px;\n    background-image: url(http://cdn1.iconfinder.com/data/icons/fugue/bonus/icons-24/target.png);\n}\n.cp-fancy .slide-indicator {\n    width: 30px;\n    height: 31px;\n    left: 30px;\n    background-image: url(http://cdn1.iconfinder.com/data/icons/bluecoral/Left.png);\n}\n\n/* Normal skin */\n\n.cp-normal {\n    padding: 10px;\n    background-color: white;\n    float: left;\n    border: 4px solid #d6d6d6;\n    box-shadow: inset 0 0 10px white;\n}\n.cp-normal .picker {\n    width: 200px;\n    height: 200px;\n}\n.cp-normal .slide {\n    width: 30px;\n    height: 200px;\n}\n.cp-normal .slide-wrapper {\n    margin-left: 10px;\n}\n.cp-normal .picker-indicator {\n    width: 5px;\n    height: 5px;\n    border: 1px solid gray;\n    opacity: .5;\n    -ms-filter: \"progid:DXImageTransform.Microsoft.Alpha(Opacity=50)\";\n    filter: progid:DXImageTransform.Microsoft.Alpha(Opacity=50);\n    filter: alpha(opacity=50);\n    background-color: white;\n    pointer-events: none;\n}\n.cp-normal .slide-indicator {\n    width: 100%;\n    height: 10px;\n    left: -4px;\n    opacity: .6;\n    -ms-filter: \"progid:DXImageTransform.Microsoft.Alpha(Opacity=60)\";\n    filter: progid:DXImageTransform.Microsoft.Alpha(Opacity=60);\n    filter: alpha(opacity=60);\n    border: 4px solid gray;\n    background-color: white;\n    pointer-events: none;\n}\n",
-	"étoile_floue.png":  "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x11\x00\x00\x00Z\b\x06\x00\x00\x00^\xf2\xbdU\x00\x00\x00\x06bKGD\x00\x00\x00\x00\x00\x00\xf9C\xbb\u007f\x00\x00\x03\x1bIDATXõ\x97\xddr\xd30\x10\x85\x8fNLC\xda\xd2rU`x\rx\xff\a\x80ǀ\x96\xd2\vȯ\x9bR[ܬ;kE\xbb\x92\x98!3\x9e\x13\xc7\xf2\xf1\xe6|^Y\x0e0>1\xc6\x15\x80O\x00>\x03\xf8\x02\xe0k\b\xa1ύ%\xecO\x90-\xfd\xdelB\x00\v\xd1\x10c\f\xd5&2x\x01`\t\xe0Lt\xd1ZI\x90\x13\xaf\x00\\\x8b.\xad\xbfD\xc7\xfc\x12\xc0{\x00\xefD/\xad\xf1\x9dcr\x05\xe0\xa3lw\xb2\xcf\xffV\t\x9dP\xcf\x01\xbcU\xdb9\x80E\x8e\x90U\xc9\x02\xc0JN\\\x8a\xae,B4ȼ\x16*\xe7\x00^\x89^\xcb\xefU\x95\x10\xc0\x05\x80\x1bɡ\x13\xbd\xb1r\xa1C\xe6\x03\x807b\xa2\xf7\xabM.\x93J.\xaa+I\xc8L\x190\xc9\xe8\x84\x10\vd:\t\xb2\xf3\b\xd1!\xb3\x9a\xbaWte\x11b\x81\f\x8d\x9cX2ɑ\xa0G\x88\x052,T871\xc8\x04\xe3.\x9e\x11b\x05\x19\x94\b\xb1@&\x1d\x9b%\xc4\n2\xa5\xbcNL\xbc\x1e1\x8f\xb3\xe6J\xa5JYA\x06%B\xac \x83\x12!V\x92q\t\xb1\x92\x8c\x9b\x1b+ɸ\x84XI\xc6%\xc4J2.!V\x92q\t\xb1\x81\x8cI\x88\rdLBl c\x12b\x03\x19\x93\x10\x1bȘ\x84\xd8@\xc6$\xc4F2YB\xadd\xb2\x84Z\xc9d\t\xb5\x92\xc9\x12\xa2Z\xaf\xe6\xc8D\x00\xa3h\x8e\xd0\x15\x80\xe5D\xe7L45\x19\x00<\x89\xa6&/\xe7M7\x1b\x8d*\x1e\x01lD\xa3\xb1\xf6'3\au\x15;\x00?E\a\xeb-\xc4\vr\x04\xb0\x05p+:z)\a\xe3}&J\x05\x0f\xa2\xf1_\xdew\x06\x00\a\x00\xbfE\x87V\x93\b\xe0YNފ>[\xd5x&G\xa9\xe2\x97\xe8\xb1\xd5d\x94\x1c\xee\x01\xfc\x10\xddY\xe1v\x8e\xc9\x06\xc07\x00ߥG6\x96IM%\xf7\xa5J<\x93^\xb2X\x8b\xf6-&\x9a\xcc^n\xf9\xbdG\x88\x0e\x99\x8d\\\xfd\x8f\xe8\xc6\"\xc4B\x1e[\xb9\xfa\xd6˅\x0e\x99\xbb\xc4\xe4\xce\"\xe4U\xa2\xbbW\xef\x17M\xa2\"\xb3\x96P\aѵ\"\x14K\x95Ldz1\x88\xa2\xbd\"T\xacd\"\xa3;w\xea\xe8,!f\xfe\xca.C\"\xf7{\xb4*IɌ!\x84\xa8f\xb9,!\x16Ȍ\x85߳&CB&&3\xffZ\x05nf2$dr\x178(j3\x93\x98<g\x0e\x00\x06\xc9\x03\xa2\x9aУ:礒\x9d\xd3#)\xa1,\x9dA\x11\xd8\x1a&\xfa\xf8\x903\x19\v=b\x1eOM\x0e\x192\xc8\x10:\xe4L\xa6٬7Ȥ\x84z=\xcb1\xe9\x99uJ\xe6\xe5\xe9='\xb4\xd6=\xc4\xca<\xdc\\h\xf5La\xa50\xeb!\xaa\xee\xdd7V\xb2\x9f\xba\x99\x95d\\B\x9d\x9a͎\xa5%D2A\x1d\xf4\xfbN\xfa\x9c9!\x93!4{\x0e\xb1\x81\x8cI\xa8Sdn\xbd'\u007ff\xf6\xbb\x95%\xe8HE\xe6A%^2\x99\x8dg\xc5z\x15\xa5\xf5-Ռ\xf6TA&\xb7ҎL\xd7\xf0\x16\x99\x84\xd0l͟\xcel\xb1\xb2\x92\xd9ؿ\xf7V\xb9\xcd\x0eQ\xe3\xe3\x00\x00\x00\x00IEND\xaeB`\x82",
+	"étoile_floue.png":  "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x11\x00\x00\x00Z\b\x06\x00\x00\x00^\xf2\xbdU\x00\x00\x00\x06bKGD\x00\x00\x00\x00\x00\x00\xf9C\xbb\x7f\x00\x00\x03\x1bIDATXõ\x97\xddr\xd30\x10\x85\x8fNLC\xda\xd2rU`x\rx\xff\a\x80ǀ\x96\xd2\vȯ\x9bR[ܬ;kE\xbb\x92\x98!3\x9e\x13\xc7\xf2\xf1\xe6|^Y\x0e0>1\xc6\x15\x80O\x00>\x03\xf8\x02\xe0k\b\xa1ύ%\xecO\x90-\xfd\xdelB\x00\v\xd1\x10c\f\xd5&2x\x01`\t\xe0Lt\xd1ZI\x90\x13\xaf\x00\\\x8b.\xad\xbfD\xc7\xfc\x12\xc0{\x00\xefD/\xad\xf1\x9dcr\x05\xe0\xa3lw\xb2\xcf\xffV\t\x9dP\xcf\x01\xbcU\xdb9\x80E\x8e\x90U\xc9\x02\xc0JN\\\x8a\xae,B4ȼ\x16*\xe7\x00^\x89^\xcb\xefU\x95\x10\xc0\x05\x80\x1bɡ\x13\xbd\xb1r\xa1C\xe6\x03\x807b\xa2\xf7\xabM.\x93J.\xaa+I\xc8L\x190\xc9\xe8\x84\x10\vd:\t\xb2\xf3\b\xd1!\xb3\x9a\xbaWte\x11b\x81\f\x8d\x9cX2ɑ\xa0G\x88\x052,T871\xc8\x04\xe3.\x9e\x11b\x05\x19\x94\b\xb1@&\x1d\x9b%\xc4\n2\xa5\xbcNL\xbc\x1e1\x8f\xb3\xe6J\xa5JYA\x06%B\xac \x83\x12!V\x92q\t\xb1\x92\x8c\x9b\x1b+ɸ\x84XI\xc6%\xc4J2.!V\x92q\t\xb1\x81\x8cI\x88\rdLBl c\x12b\x03\x19\x93\x10\x1bȘ\x84\xd8@\xc6$\xc4F2YB\xadd\xb2\x84Z\xc9d\t\xb5\x92\xc9\x12\xa2Z\xaf\xe6\xc8D\x00\xa3h\x8e\xd0\x15\x80\xe5D\xe7L45\x19\x00<\x89\xa6&/\xe7M7\x1b\x8d*\x1e\x01lD\xa3\xb1\xf6'3\au\x15;\x00?E\a\xeb-\xc4\vr\x04\xb0\x05p+:z)\a\xe3}&J\x05\x0f\xa2\xf1_\xdew\x06\x00\a\x00\xbfE\x87V\x93\b\xe0YNފ>[\xd5x&G\xa9\xe2\x97\xe8\xb1\xd5d\x94\x1c\xee\x01\xfc\x10\xddY\xe1v\x8e\xc9\x06\xc07\x00ߥG6\x96IM%\xf7\xa5J<\x93^\xb2X\x8b\xf6-&\x9a\xcc^n\xf9\xbdG\x88\x0e\x99\x8d\\\xfd\x8f\xe8\xc6\"\xc4B\x1e[\xb9\xfa\xd6˅\x0e\x99\xbb\xc4\xe4\xce\"\xe4U\xa2\xbbW\xef\x17M\xa2\"\xb3\x96P\aѵ\"\x14K\x95Ldz1\x88\xa2\xbd\"T\xacd\"\xa3;w\xea\xe8,!f\xfe\xca.C\"\xf7{\xb4*IɌ!\x84\xa8f\xb9,!\x16Ȍ\x85߳&CB&&3\xffZ\x05nf2$dr\x178(j3\x93\x98<g\x0e\x00\x06\xc9\x03\xa2\x9aУ:礒\x9d\xd3#)\xa1,\x9dA\x11\xd8\x1a&\xfa\xf8\x903\x19\v=b\x1eOM\x0e\x192\xc8\x10:\xe4L\xa6٬7Ȥ\x84z=\xcb1\xe9\x99uJ\xe6\xe5\xe9='\xb4\xd6=\xc4\xca<\xdc\\h\xf5La\xa50\xeb!\xaa\xee\xdd7V\xb2\x9f\xba\x99\x95d\\B\x9d\x9a͎\xa5%D2A\x1d\xf4\xfbN\xfa\x9c9!\x93!4{\x0e\xb1\x81\x8cI\xa8Sdn\xbd'\x7ff\xf6\xbb\x95%\xe8HE\xe6A%^2\x99\x8dg\xc5z\x15\xa5\xf5-Ռ\xf6TA&\xb7ҎL\xd7\xf0\x16\x99\x84\xd0l͟\xcel\xb1\xb2\x92\xd9ؿ\xf7V\xb9\xcd\x0eQ\xe3\xe3\x00\x00\x00\x00IEND\xaeB`\x82",
 	"étoile_orange.png": "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x0f\x00\x00\x00\x0f\b\x06\x00\x00\x00;֕J\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\xb4IDAT(\xcfc`\xc0\x02\xfe\xcfa`\x03bN$\xcc\xc6@\b\x00\x151\x031\x0f\x10\x8b\x02\xb1\x12\x12\x16\x85\x8a3\xe3\xd3\xc8\x0fĪ@l\n\xc4~H\xd8\x14*Ώ\xd5\x00$\x8d.@\x9c\x01\xc4u@\xdc\x0e\xa53\xa0\xe2`\x03\xb0\xf9Q\n\x88m\x808\x0f\x88\xe7\x01\xf1\x0e >\x0e\xa5\xe7A\xc5m\xa0\xea\xd8\xd0m\xd5\x00\xe2p \xee\x06\xe2\xa3@\xfc\f\x88?A\xe9\xa3P\xf1p\xa8:~d\xbf\n\x01\xb11\x10\xa7\x01\xf1\x1c \xbe\t\xc4?\x80\xf8\x1f\x94\xbe\t\x15O\x83\xaa\x13\x02\xfb\x9d\"\xcd\x149\x9b\xe2\x00\xa3(\xaa(N$\x14%O\x8a3\x06\x16W0\xe3\xb3\r\x00x\xc6-F\x8faʛ\x00\x00\x00\x00IEND\xaeB`\x82",
 }
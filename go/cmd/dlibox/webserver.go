@@ -10,10 +10,12 @@ import (
 	"fmt"
 	"html/template"
 	"log"
+	"math"
 	"mime"
 	"net/http"
 	"os"
 	"path"
+	"strconv"
 
 	"github.com/maruel/dlibox/go/anim1d"
 )
@@ -55,7 +57,9 @@ func startWebServer(port int, painter *anim1d.Painter, config *Config) *webServe
 	// Dynamic replies.
 	mux.HandleFunc("/config", ws.configHandler)
 	mux.HandleFunc("/switch", ws.switchHandler)
+	mux.HandleFunc("/style", ws.styleHandler)
 	mux.HandleFunc("/thumbnail/", ws.thumbnailHandler)
+	mux.HandleFunc("/variable", ws.variableHandler)
 	go http.ListenAndServe(fmt.Sprintf(":%d", port), loggingHandler{mux})
 	return ws
 }
@@ -72,9 +76,11 @@ func (s *webServer) rootHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/html")
 	//w.Header().Set("Cache-Control", "Cache-Control:public, max-age=2592000") // 30d
 	keys := struct {
-		Host string
+		Host  string
+		Style anim1d.TransitionStyle
 	}{
 		hostName,
+		s.config.APA102.TransitionStyle,
 	}
 	if err := rootTmpl.Execute(w, keys); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -154,6 +160,19 @@ func (s *webServer) switchHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *webServer) styleHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Ugh", http.StatusMethodNotAllowed)
+		return
+	}
+	style := anim1d.TransitionStyle(r.PostFormValue("style"))
+	if err := s.painter.SetTransitionStyle(style); err != nil {
+		http.Error(w, "invalid transition style", http.StatusBadRequest)
+		return
+	}
+	s.config.APA102.TransitionStyle = style
+}
+
 func (s *webServer) thumbnailHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != "GET" {
 		http.Error(w, "Ugh", http.StatusMethodNotAllowed)
@@ -175,6 +194,40 @@ func (s *webServer) thumbnailHandler(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(data)
 }
 
+// variableHandler returns all the variables on GET and sets one on POST.
+//
+// POST takes the form values "name" and "value"; an empty value deletes the
+// variable.
+func (s *webServer) variableHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		data, _ := json.Marshal(anim1d.Variables())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	case "POST":
+		name := r.PostFormValue("name")
+		if len(name) == 0 {
+			http.Error(w, "name is required", http.StatusBadRequest)
+			return
+		}
+		v := r.PostFormValue("value")
+		if len(v) == 0 {
+			log.Printf("variable %q deleted", name)
+			anim1d.DeleteVariable(name)
+			return
+		}
+		f, err := strconv.ParseFloat(v, 32)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			http.Error(w, "value is not a number", http.StatusBadRequest)
+			return
+		}
+		log.Printf("variable %q = %g", name, f)
+		anim1d.SetVariable(name, float32(f))
+	default:
+		http.Error(w, "Ugh", http.StatusMethodNotAllowed)
+	}
+}
+
 // Private details.
 
 type loggingHandler struct {
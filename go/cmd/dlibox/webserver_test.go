@@ -0,0 +1,39 @@
+// Copyright 2016 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/maruel/dlibox/go/anim1d"
+	"github.com/maruel/ut"
+)
+
+func TestVariableHandler(t *testing.T) {
+	s := &webServer{}
+	post := func(values url.Values) int {
+		r := httptest.NewRequest("POST", "/variable", strings.NewReader(values.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		s.variableHandler(w, r)
+		return w.Code
+	}
+	ut.AssertEqual(t, http.StatusOK, post(url.Values{"name": {"test"}, "value": {"42.5"}}))
+	ut.AssertEqual(t, float32(42.5), anim1d.Variables()["test"])
+	for _, v := range []string{"foo", "NaN", "nan", "Inf", "-Inf", "+infinity", "1e40"} {
+		if code := post(url.Values{"name": {"test"}, "value": {v}}); code != http.StatusBadRequest {
+			t.Fatalf("%q: got %d", v, code)
+		}
+	}
+	ut.AssertEqual(t, float32(42.5), anim1d.Variables()["test"])
+	ut.AssertEqual(t, http.StatusBadRequest, post(url.Values{"value": {"1"}}))
+	ut.AssertEqual(t, http.StatusOK, post(url.Values{"name": {"test"}}))
+	_, ok := anim1d.Variables()["test"]
+	ut.AssertEqual(t, false, ok)
+}